@@ -2,140 +2,312 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
 	baseURL = "https://api.wpengineapi.com/v1"
+
+	// pageSize is the largest page the list endpoints will return.
+	pageSize = 100
+)
+
+var (
+	// ErrInvalidCredentials is returned when the API rejects the username and
+	// password pair.
+	ErrInvalidCredentials = errors.New("invalid API credentials")
+	// ErrAPIAccessDisabled is returned when the credentials are valid but API
+	// access has not been enabled for the account.
+	ErrAPIAccessDisabled = errors.New("API access is not enabled for this account")
 )
 
 type ApiClient struct {
-	apiKey     string
+	username   string
+	password   string
+	baseURL    string
 	httpClient *http.Client
+
+	// pollInterval is the initial interval used by the WaitFor helpers. Zero
+	// uses the Poll default.
+	pollInterval time.Duration
 }
 
-func NewClient(apiKey string) *ApiClient {
-	return &ApiClient{
-		apiKey:     apiKey,
+// Option configures optional behaviour of an ApiClient.
+type Option func(*ApiClient)
+
+// WithBaseURL points the client at a different API endpoint, e.g. a test
+// server.
+func WithBaseURL(url string) Option {
+	return func(c *ApiClient) {
+		c.baseURL = url
+	}
+}
+
+// WithPollInterval sets the initial interval the WaitFor helpers use between
+// status checks.
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *ApiClient) {
+		c.pollInterval = interval
+	}
+}
+
+func NewClient(username, password string, opts ...Option) *ApiClient {
+	c := &ApiClient{
+		username:   username,
+		password:   password,
+		baseURL:    baseURL,
 		httpClient: &http.Client{},
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-func (c *ApiClient) doRequest(req *http.Request) ([]byte, error) {
-	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+// APIError is returned when the API answers with a status code the caller did
+// not expect.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("error, status code: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// jobCompleted is a Poll predicate for the asynchronous jobs the API tracks
+// with a status attribute, such as backups and copies.
+func jobCompleted(job map[string]interface{}) (bool, error) {
+	switch job["status"] {
+	case "completed":
+		return true, nil
+	case "failed", "cancelled", "canceled":
+		return false, fmt.Errorf("finished with status %q", job["status"])
+	}
+	return false, nil
+}
+
+// IsNotFound reports whether err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// request describes a single call against the API. A nil body sends no
+// payload, and an empty expect list accepts any status code below 400.
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	body   any
+	expect []int
+}
+
+func (c *ApiClient) doRequest(req *http.Request) (*http.Response, []byte, error) {
+	req.SetBasicAuth(c.username, c.password)
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if resp.StatusCode >= 400 {
-		// Here we could map API specific error messages to more user-friendly ones or handle them accordingly
-		return nil, fmt.Errorf("error, status code: %d, body: %s", resp.StatusCode, string(body))
-	}
-
-	return body, nil
+	return resp, body, nil
 }
 
-// #############################################################################
-// account
-// #############################################################################
+// send builds the HTTP request for r, performs it and returns the raw response
+// body once the status code has been checked.
+func (c *ApiClient) send(ctx context.Context, r request) ([]byte, error) {
+	endpoint := c.baseURL + r.path
+	if len(r.query) > 0 {
+		endpoint += "?" + r.query.Encode()
+	}
 
-func (c *ApiClient) GetAccount(accountID string) (map[string]interface{}, error) {
-	accountEndpoint := fmt.Sprintf("%s/accounts/%s", baseURL, accountID)
+	var payload io.Reader
+	if r.body != nil {
+		data, err := json.Marshal(r.body)
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewBuffer(data)
+	}
 
-	req, err := http.NewRequest("GET", accountEndpoint, nil)
+	req, err := http.NewRequestWithContext(ctx, r.method, endpoint, payload)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	resp, body, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
-	var account map[string]interface{}
-	err = json.Unmarshal(body, &account)
-	if err != nil {
-		return nil, err
+	if !expected(resp.StatusCode, r.expect) {
+		// Here we could map API specific error messages to more user-friendly ones or handle them accordingly
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
-	return account, nil
+	return body, nil
 }
 
-func (c *ApiClient) CreateAccount(accountData map[string]interface{}) (map[string]interface{}, error) {
-	accountEndpoint := fmt.Sprintf("%s/accounts", baseURL)
-
-	accountDataBytes, err := json.Marshal(accountData)
-	if err != nil {
-		return nil, err
+func expected(status int, codes []int) bool {
+	if len(codes) == 0 {
+		return status < 400
 	}
+	for _, code := range codes {
+		if status == code {
+			return true
+		}
+	}
+	return false
+}
+
+// do performs r and decodes the response body into a T. Empty bodies, as
+// returned by most DELETE endpoints, leave the zero value in place.
+func do[T any](ctx context.Context, c *ApiClient, r request) (T, error) {
+	var result T
 
-	req, err := http.NewRequest("POST", accountEndpoint, bytes.NewBuffer(accountDataBytes))
+	body, err := c.send(ctx, r)
 	if err != nil {
-		return nil, err
+		return result, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+	if len(bytes.TrimSpace(body)) == 0 {
+		return result, nil
 	}
 
-	var account map[string]interface{}
-	err = json.Unmarshal(body, &account)
+	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return nil, err
+		return result, err
 	}
 
-	return account, nil
+	return result, nil
 }
 
-func (c *ApiClient) UpdateAccount(accountID string, accountData map[string]interface{}) (map[string]interface{}, error) {
-	accountEndpoint := fmt.Sprintf("%s/accounts/%s", baseURL, accountID)
+// page is the envelope the API wraps around every list response.
+type page[T any] struct {
+	Next    *string `json:"next"`
+	Count   int     `json:"count"`
+	Results []T     `json:"results"`
+}
 
-	accountDataBytes, err := json.Marshal(accountData)
-	if err != nil {
-		return nil, err
+// list walks every page of a list endpoint and returns the combined results.
+// Callers may pass extra query parameters such as filters; limit and offset
+// are managed here.
+func list[T any](ctx context.Context, c *ApiClient, path string, query url.Values) ([]T, error) {
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = values
+	}
+	params.Set("limit", strconv.Itoa(pageSize))
+
+	var results []T
+	for {
+		params.Set("offset", strconv.Itoa(len(results)))
+
+		p, err := do[page[T]](ctx, c, request{
+			method: http.MethodGet,
+			path:   path,
+			query:  params,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, p.Results...)
+		if p.Next == nil || len(p.Results) == 0 || len(results) >= p.Count {
+			return results, nil
+		}
 	}
+}
 
-	req, err := http.NewRequest("PUT", accountEndpoint, bytes.NewBuffer(accountDataBytes))
-	if err != nil {
-		return nil, err
-	}
+// GetStatus reports whether the API is currently healthy.
+func (c *ApiClient) GetStatus(ctx context.Context) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   "/status",
+	})
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// CheckCredentials makes a single authenticated call and reports whether the
+// credentials can be used, wrapping ErrInvalidCredentials or
+// ErrAPIAccessDisabled when the API turns them down.
+func (c *ApiClient) CheckCredentials(ctx context.Context) error {
+	_, err := c.GetCurrentUser(ctx)
 
-	var account map[string]interface{}
-	err = json.Unmarshal(body, &account)
-	if err != nil {
-		return nil, err
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%w: %s", ErrInvalidCredentials, apiErr)
+		case http.StatusForbidden:
+			return fmt.Errorf("%w: %s", ErrAPIAccessDisabled, apiErr)
+		}
 	}
 
-	return account, nil
+	return err
 }
 
-func (c *ApiClient) DeleteAccount(accountID string) error {
-	accountEndpoint := fmt.Sprintf("%s/accounts/%s", baseURL, accountID)
+// #############################################################################
+// account
+// #############################################################################
 
-	req, err := http.NewRequest("DELETE", accountEndpoint, nil)
-	if err != nil {
-		return err
-	}
+// ListAccounts returns every account the credentials have access to.
+func (c *ApiClient) ListAccounts(ctx context.Context) ([]map[string]interface{}, error) {
+	return list[map[string]interface{}](ctx, c, "/accounts", nil)
+}
+
+func (c *ApiClient) GetAccount(ctx context.Context, accountID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/accounts/%s", accountID),
+	})
+}
+
+func (c *ApiClient) CreateAccount(ctx context.Context, accountData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   "/accounts",
+		body:   accountData,
+	})
+}
 
-	_, err = c.doRequest(req)
+func (c *ApiClient) UpdateAccount(ctx context.Context, accountID string, accountData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/accounts/%s", accountID),
+		body:   accountData,
+	})
+}
+
+func (c *ApiClient) PatchAccount(ctx context.Context, accountID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/accounts/%s", accountID),
+		body:   fields,
+	})
+}
+
+func (c *ApiClient) DeleteAccount(ctx context.Context, accountID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/accounts/%s", accountID),
+	})
 	return err
 }
 
@@ -145,185 +317,295 @@ func (c *ApiClient) DeleteAccount(accountID string) error {
 // account_user
 // #############################################################################
 
-func (c *ApiClient) CreateAccountUser(accountID string, userData map[string]interface{}) (map[string]interface{}, error) {
-	userEndpoint := fmt.Sprintf("%s/accounts/%s/account_users", baseURL, accountID)
-
-	userDataBytes, err := json.Marshal(userData)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", userEndpoint, bytes.NewBuffer(userDataBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// ListAccountUsers returns every user with access to an account.
+func (c *ApiClient) ListAccountUsers(ctx context.Context, accountID string) ([]map[string]interface{}, error) {
+	return list[map[string]interface{}](ctx, c, fmt.Sprintf("/accounts/%s/account_users", accountID), nil)
+}
 
-	var user map[string]interface{}
-	err = json.Unmarshal(body, &user)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) CreateAccountUser(ctx context.Context, accountID string, userData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/accounts/%s/account_users", accountID),
+		body:   userData,
+	})
+}
 
-	return user, nil
+func (c *ApiClient) GetAccountUser(ctx context.Context, userID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/users/%s", userID),
+	})
 }
 
-func (c *ApiClient) GetAccountUser(userID string) (map[string]interface{}, error) {
-	userEndpoint := fmt.Sprintf("%s/users/%s", baseURL, userID)
+func (c *ApiClient) UpdateAccountUser(ctx context.Context, userID string, userData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/users/%s", userID),
+		body:   userData,
+	})
+}
 
-	req, err := http.NewRequest("GET", userEndpoint, nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) PatchAccountUser(ctx context.Context, userID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/users/%s", userID),
+		body:   fields,
+	})
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) DeleteAccountUser(ctx context.Context, userID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/users/%s", userID),
+	})
+	return err
+}
 
-	var user map[string]interface{}
-	err = json.Unmarshal(body, &user)
-	if err != nil {
-		return nil, err
-	}
+// GetAccountUserForAccount retrieves a user's roles and install access on an
+// account.
+func (c *ApiClient) GetAccountUserForAccount(ctx context.Context, accountID, userID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/accounts/%s/account_users/%s", accountID, userID),
+	})
+}
 
-	return user, nil
+// PatchAccountUserForAccount changes only the given fields of a user's access
+// to an account, such as roles and install_ids.
+func (c *ApiClient) PatchAccountUserForAccount(ctx context.Context, accountID, userID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/accounts/%s/account_users/%s", accountID, userID),
+		body:   fields,
+	})
 }
 
-func (c *ApiClient) UpdateAccountUser(userID string, userData map[string]interface{}) (map[string]interface{}, error) {
-	userEndpoint := fmt.Sprintf("%s/users/%s", baseURL, userID)
+// DeleteAccountUserForAccount removes a user's access to an account.
+func (c *ApiClient) DeleteAccountUserForAccount(ctx context.Context, accountID, userID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/accounts/%s/account_users/%s", accountID, userID),
+	})
+	return err
+}
 
-	userDataBytes, err := json.Marshal(userData)
-	if err != nil {
-		return nil, err
-	}
+// AccountUserRoles are the roles a user can hold on an account. The API joins
+// a user's roles into one comma separated string, e.g. "full,billing".
+var AccountUserRoles = []string{"owner", "full", "partial", "billing"}
 
-	req, err := http.NewRequest("PUT", userEndpoint, bytes.NewBuffer(userDataBytes))
-	if err != nil {
-		return nil, err
+// IsAccountUserRole reports whether role is one of AccountUserRoles.
+func IsAccountUserRole(role string) bool {
+	for _, r := range AccountUserRoles {
+		if r == role {
+			return true
+		}
 	}
+	return false
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+// SplitRoles splits a comma separated roles string into its roles.
+func SplitRoles(roles string) []string {
+	var result []string
+	for _, role := range strings.Split(roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			result = append(result, role)
+		}
 	}
+	return result
+}
 
-	var user map[string]interface{}
-	err = json.Unmarshal(body, &user)
-	if err != nil {
-		return nil, err
+// HasRole reports whether a comma separated roles string includes role.
+func HasRole(roles, role string) bool {
+	for _, r := range SplitRoles(roles) {
+		if r == role {
+			return true
+		}
 	}
+	return false
+}
 
-	return user, nil
+// AccountUserRolesOf returns the roles of an account user.
+func AccountUserRolesOf(user map[string]interface{}) []string {
+	roles, _ := user["roles"].(string)
+	return SplitRoles(roles)
 }
 
-func (c *ApiClient) DeleteAccountUser(userID string) error {
-	userEndpoint := fmt.Sprintf("%s/users/%s", baseURL, userID)
+// AccountUserInstallIDs returns the installs a partial user has been granted.
+func AccountUserInstallIDs(user map[string]interface{}) []string {
+	installs, _ := user["installs"].([]interface{})
 
-	req, err := http.NewRequest("DELETE", userEndpoint, nil)
-	if err != nil {
-		return err
+	var ids []string
+	for _, raw := range installs {
+		install, _ := raw.(map[string]interface{})
+		if id, ok := install["id"].(string); ok {
+			ids = append(ids, id)
+		}
 	}
+	return ids
+}
 
-	_, err = c.doRequest(req)
-	return err
+// AccountUserHasInstall reports whether a partial user has been granted an
+// install.
+func AccountUserHasInstall(user map[string]interface{}, installID string) bool {
+	for _, id := range AccountUserInstallIDs(user) {
+		if id == installID {
+			return true
+		}
+	}
+	return false
 }
 
 // end account_user
 
 // #############################################################################
-// cdn
+// user
 // #############################################################################
 
-func (c *ApiClient) GetCDN(cdnID string) (map[string]interface{}, error) {
-	cdnEndpoint := fmt.Sprintf("%s/cdns/%s", baseURL, cdnID)
-
-	req, err := http.NewRequest("GET", cdnEndpoint, nil)
-	if err != nil {
-		return nil, err
-	}
+// GetCurrentUser returns the account user the API credentials belong to.
+func (c *ApiClient) GetCurrentUser(ctx context.Context) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   "/user",
+	})
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// end user
 
-	var cdn map[string]interface{}
-	err = json.Unmarshal(body, &cdn)
-	if err != nil {
-		return nil, err
-	}
+// #############################################################################
+// backup
+// #############################################################################
 
-	return cdn, nil
+// CreateBackup requests a backup of an install. The backup is taken
+// asynchronously; use WaitForBackup to wait for it to finish.
+func (c *ApiClient) CreateBackup(ctx context.Context, installID string, backupData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/installs/%s/backups", installID),
+		body:   backupData,
+		expect: []int{http.StatusAccepted, http.StatusCreated, http.StatusOK},
+	})
 }
 
-func (c *ApiClient) CreateCDN(cdnData map[string]interface{}) (map[string]interface{}, error) {
-	cdnEndpoint := fmt.Sprintf("%s/cdns", baseURL)
+// ListBackups returns every backup of an install.
+func (c *ApiClient) ListBackups(ctx context.Context, installID string) ([]map[string]interface{}, error) {
+	return list[map[string]interface{}](ctx, c, fmt.Sprintf("/installs/%s/backups", installID), nil)
+}
 
-	cdnDataBytes, err := json.Marshal(cdnData)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) GetBackup(ctx context.Context, installID, backupID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/installs/%s/backups/%s", installID, backupID),
+	})
+}
 
-	req, err := http.NewRequest("POST", cdnEndpoint, bytes.NewBuffer(cdnDataBytes))
-	if err != nil {
-		return nil, err
-	}
+// WaitForBackup polls a backup until it has completed, returning an error if
+// it fails or the context expires first.
+func (c *ApiClient) WaitForBackup(ctx context.Context, installID, backupID string) (map[string]interface{}, error) {
+	return Poll(ctx,
+		func(ctx context.Context) (map[string]interface{}, error) {
+			return c.GetBackup(ctx, installID, backupID)
+		},
+		jobCompleted,
+		PollOptions{
+			Description: fmt.Sprintf("backup %s of install %s", backupID, installID),
+			Interval:    c.pollInterval,
+		},
+	)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// RestoreBackup starts restoring a backup onto its install, overwriting the
+// install's files and database. The restore runs asynchronously; use
+// WaitForRestore to wait for it to finish.
+func (c *ApiClient) RestoreBackup(ctx context.Context, installID, backupID string, restoreData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/installs/%s/backups/%s/restore", installID, backupID),
+		body:   restoreData,
+		expect: []int{http.StatusAccepted, http.StatusCreated, http.StatusOK},
+	})
+}
 
-	var cdn map[string]interface{}
-	err = json.Unmarshal(body, &cdn)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) GetRestore(ctx context.Context, installID, restoreID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/installs/%s/restores/%s", installID, restoreID),
+	})
+}
 
-	return cdn, nil
+// WaitForRestore polls a restore until it has completed, returning an error
+// if it fails or the context expires first.
+func (c *ApiClient) WaitForRestore(ctx context.Context, installID, restoreID string) (map[string]interface{}, error) {
+	return Poll(ctx,
+		func(ctx context.Context) (map[string]interface{}, error) {
+			return c.GetRestore(ctx, installID, restoreID)
+		},
+		jobCompleted,
+		PollOptions{
+			Description: fmt.Sprintf("restore %s of install %s", restoreID, installID),
+			Interval:    c.pollInterval,
+		},
+	)
 }
 
-func (c *ApiClient) UpdateCDN(cdnID string, cdnData map[string]interface{}) (map[string]interface{}, error) {
-	cdnEndpoint := fmt.Sprintf("%s/cdns/%s", baseURL, cdnID)
+// end backup
 
-	cdnDataBytes, err := json.Marshal(cdnData)
-	if err != nil {
-		return nil, err
-	}
+// #############################################################################
+// cache
+// #############################################################################
 
-	req, err := http.NewRequest("PUT", cdnEndpoint, bytes.NewBuffer(cdnDataBytes))
-	if err != nil {
-		return nil, err
-	}
+// PurgeCache clears one of an install's caches. cacheType is "object", "page"
+// or "cdn". The returned purge is nil when the API answers without a body.
+func (c *ApiClient) PurgeCache(ctx context.Context, installID, cacheType string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/installs/%s/purge_cache", installID),
+		body:   map[string]interface{}{"type": cacheType},
+		expect: []int{http.StatusAccepted, http.StatusOK, http.StatusNoContent},
+	})
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// end cache
 
-	var cdn map[string]interface{}
-	err = json.Unmarshal(body, &cdn)
-	if err != nil {
-		return nil, err
-	}
+// #############################################################################
+// cdn
+// #############################################################################
 
-	return cdn, nil
+func (c *ApiClient) GetCDN(ctx context.Context, cdnID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/cdns/%s", cdnID),
+	})
 }
 
-func (c *ApiClient) DeleteCDN(cdnID string) error {
-	cdnEndpoint := fmt.Sprintf("%s/cdns/%s", baseURL, cdnID)
+func (c *ApiClient) CreateCDN(ctx context.Context, cdnData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   "/cdns",
+		body:   cdnData,
+	})
+}
 
-	req, err := http.NewRequest("DELETE", cdnEndpoint, nil)
-	if err != nil {
-		return err
-	}
+func (c *ApiClient) UpdateCDN(ctx context.Context, cdnID string, cdnData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/cdns/%s", cdnID),
+		body:   cdnData,
+	})
+}
+
+func (c *ApiClient) PatchCDN(ctx context.Context, cdnID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/cdns/%s", cdnID),
+		body:   fields,
+	})
+}
 
-	_, err = c.doRequest(req)
+func (c *ApiClient) DeleteCDN(ctx context.Context, cdnID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/cdns/%s", cdnID),
+	})
 	return err
 }
 
@@ -332,96 +614,155 @@ func (c *ApiClient) DeleteCDN(cdnID string) error {
 // #############################################################################
 // domain
 // #############################################################################
-// GetDomain retrieves details of a specific domain.
-func (c *ApiClient) GetDomain(domainID string) (map[string]interface{}, error) {
-	domainEndpoint := fmt.Sprintf("%s/domains/%s", baseURL, domainID)
-
-	req, err := http.NewRequest("GET", domainEndpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var domain map[string]interface{}
-	err = json.Unmarshal(body, &domain)
-	if err != nil {
-		return nil, err
-	}
+// ListDomains retrieves every domain attached to an install.
+func (c *ApiClient) ListDomains(ctx context.Context, installID string) ([]map[string]interface{}, error) {
+	return list[map[string]interface{}](ctx, c, fmt.Sprintf("/installs/%s/domains", installID), nil)
+}
 
-	return domain, nil
+// GetDomain retrieves details of a specific domain.
+func (c *ApiClient) GetDomain(ctx context.Context, domainID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/domains/%s", domainID),
+	})
 }
 
 // CreateDomain sets up a new domain configuration.
-func (c *ApiClient) CreateDomain(domainData map[string]interface{}) (map[string]interface{}, error) {
-	domainEndpoint := fmt.Sprintf("%s/domains", baseURL)
-
-	domainDataBytes, err := json.Marshal(domainData)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) CreateDomain(ctx context.Context, domainData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   "/domains",
+		body:   domainData,
+	})
+}
 
-	req, err := http.NewRequest("POST", domainEndpoint, bytes.NewBuffer(domainDataBytes))
-	if err != nil {
-		return nil, err
-	}
+// UpdateDomain modifies a specific domain configuration.
+func (c *ApiClient) UpdateDomain(ctx context.Context, domainID string, domainData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/domains/%s", domainID),
+		body:   domainData,
+	})
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// PatchDomain modifies only the given fields of a specific domain configuration.
+func (c *ApiClient) PatchDomain(ctx context.Context, domainID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/domains/%s", domainID),
+		body:   fields,
+	})
+}
 
-	var domain map[string]interface{}
-	err = json.Unmarshal(body, &domain)
-	if err != nil {
-		return nil, err
-	}
+// DeleteDomain removes a specific domain configuration.
+func (c *ApiClient) DeleteDomain(ctx context.Context, domainID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/domains/%s", domainID),
+	})
+	return err
+}
 
-	return domain, nil
+// CreateInstallDomain attaches a new domain to an install.
+func (c *ApiClient) CreateInstallDomain(ctx context.Context, installID string, domainData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/installs/%s/domains", installID),
+		body:   domainData,
+	})
 }
 
-// UpdateDomain modifies a specific domain configuration.
-func (c *ApiClient) UpdateDomain(domainID string, domainData map[string]interface{}) (map[string]interface{}, error) {
-	domainEndpoint := fmt.Sprintf("%s/domains/%s", baseURL, domainID)
+// GetInstallDomain retrieves a domain attached to an install.
+func (c *ApiClient) GetInstallDomain(ctx context.Context, installID, domainID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/installs/%s/domains/%s", installID, domainID),
+	})
+}
 
-	domainDataBytes, err := json.Marshal(domainData)
-	if err != nil {
-		return nil, err
-	}
+// PatchInstallDomain modifies only the given fields of a domain attached to an
+// install, e.g. its primary flag or redirect.
+func (c *ApiClient) PatchInstallDomain(ctx context.Context, installID, domainID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/installs/%s/domains/%s", installID, domainID),
+		body:   fields,
+	})
+}
 
-	req, err := http.NewRequest("PUT", domainEndpoint, bytes.NewBuffer(domainDataBytes))
-	if err != nil {
-		return nil, err
-	}
+// DeleteInstallDomain detaches a domain from an install.
+func (c *ApiClient) DeleteInstallDomain(ctx context.Context, installID, domainID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/installs/%s/domains/%s", installID, domainID),
+	})
+	return err
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// SetSSLCertificate requests a Let's Encrypt certificate for a domain or
+// uploads a custom one, replacing any existing certificate. Issuance is
+// asynchronous; use WaitForSSLCertificate to wait for it.
+func (c *ApiClient) SetSSLCertificate(ctx context.Context, installID, domainID string, certificateData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/installs/%s/domains/%s/ssl_certificate", installID, domainID),
+		body:   certificateData,
+		expect: []int{http.StatusAccepted, http.StatusCreated, http.StatusOK},
+	})
+}
 
-	var domain map[string]interface{}
-	err = json.Unmarshal(body, &domain)
-	if err != nil {
-		return nil, err
-	}
+// GetSSLCertificate retrieves the certificate installed for a domain.
+func (c *ApiClient) GetSSLCertificate(ctx context.Context, installID, domainID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/installs/%s/domains/%s/ssl_certificate", installID, domainID),
+	})
+}
 
-	return domain, nil
+// DeleteSSLCertificate removes the certificate installed for a domain.
+func (c *ApiClient) DeleteSSLCertificate(ctx context.Context, installID, domainID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/installs/%s/domains/%s/ssl_certificate", installID, domainID),
+	})
+	return err
 }
 
-// DeleteDomain removes a specific domain configuration.
-func (c *ApiClient) DeleteDomain(domainID string) error {
-	domainEndpoint := fmt.Sprintf("%s/domains/%s", baseURL, domainID)
+// WaitForSSLCertificate polls a domain's certificate until it is active,
+// returning an error if issuance fails or the context expires first.
+func (c *ApiClient) WaitForSSLCertificate(ctx context.Context, installID, domainID string) (map[string]interface{}, error) {
+	return Poll(ctx,
+		func(ctx context.Context) (map[string]interface{}, error) {
+			return c.GetSSLCertificate(ctx, installID, domainID)
+		},
+		func(certificate map[string]interface{}) (bool, error) {
+			switch certificate["status"] {
+			case "active":
+				return true, nil
+			case "failed", "expired", "revoked":
+				return false, fmt.Errorf("certificate has status %q", certificate["status"])
+			}
+			return false, nil
+		},
+		PollOptions{
+			Description: fmt.Sprintf("SSL certificate for domain %s of install %s", domainID, installID),
+			Interval:    c.pollInterval,
+		},
+	)
+}
 
-	req, err := http.NewRequest("DELETE", domainEndpoint, nil)
-	if err != nil {
-		return err
+// DomainRedirectTarget returns the domain a domain redirects to, or nil when
+// it does not redirect. The API reports the target either as a single object
+// under redirect_to or as a list of objects under redirects_to.
+func DomainRedirectTarget(domain map[string]interface{}) map[string]interface{} {
+	if target, ok := domain["redirect_to"].(map[string]interface{}); ok {
+		return target
 	}
-
-	_, err = c.doRequest(req)
-	return err
+	if targets, ok := domain["redirects_to"].([]interface{}); ok && len(targets) > 0 {
+		target, _ := targets[0].(map[string]interface{})
+		return target
+	}
+	return nil
 }
 
 // end domain
@@ -430,92 +771,104 @@ func (c *ApiClient) DeleteDomain(domainID string) error {
 // install
 // #############################################################################
 
-func (c *ApiClient) GetInstall(installID string) (map[string]interface{}, error) {
-	installEndpoint := fmt.Sprintf("%s/installs/%s", baseURL, installID)
-
-	req, err := http.NewRequest("GET", installEndpoint, nil)
-	if err != nil {
-		return nil, err
+// ListInstalls returns every install visible to the credentials, limited to a
+// single account when accountID is not empty.
+func (c *ApiClient) ListInstalls(ctx context.Context, accountID string) ([]map[string]interface{}, error) {
+	query := url.Values{}
+	if accountID != "" {
+		query.Set("account_id", accountID)
 	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var install map[string]interface{}
-	err = json.Unmarshal(body, &install)
-	if err != nil {
-		return nil, err
-	}
-
-	return install, nil
+	return list[map[string]interface{}](ctx, c, "/installs", query)
 }
 
-func (c *ApiClient) CreateInstall(installData map[string]interface{}) (map[string]interface{}, error) {
-	installEndpoint := fmt.Sprintf("%s/installs", baseURL)
-
-	installDataBytes, err := json.Marshal(installData)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", installEndpoint, bytes.NewBuffer(installDataBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var install map[string]interface{}
-	err = json.Unmarshal(body, &install)
-	if err != nil {
-		return nil, err
-	}
-
-	return install, nil
+func (c *ApiClient) GetInstall(ctx context.Context, installID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/installs/%s", installID),
+	})
 }
 
-func (c *ApiClient) UpdateInstall(installID string, installData map[string]interface{}) (map[string]interface{}, error) {
-	installEndpoint := fmt.Sprintf("%s/installs/%s", baseURL, installID)
-
-	installDataBytes, err := json.Marshal(installData)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) CreateInstall(ctx context.Context, installData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   "/installs",
+		body:   installData,
+	})
+}
 
-	req, err := http.NewRequest("PUT", installEndpoint, bytes.NewBuffer(installDataBytes))
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) UpdateInstall(ctx context.Context, installID string, installData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/installs/%s", installID),
+		body:   installData,
+	})
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) PatchInstall(ctx context.Context, installID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/installs/%s", installID),
+		body:   fields,
+	})
+}
 
-	var install map[string]interface{}
-	err = json.Unmarshal(body, &install)
-	if err != nil {
-		return nil, err
-	}
+func (c *ApiClient) DeleteInstall(ctx context.Context, installID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/installs/%s", installID),
+	})
+	return err
+}
 
-	return install, nil
+// WaitForInstallPHPVersion polls an install until it reports running the
+// given PHP version.
+func (c *ApiClient) WaitForInstallPHPVersion(ctx context.Context, installID, phpVersion string) (map[string]interface{}, error) {
+	return Poll(ctx,
+		func(ctx context.Context) (map[string]interface{}, error) {
+			return c.GetInstall(ctx, installID)
+		},
+		func(install map[string]interface{}) (bool, error) {
+			return install["php_version"] == phpVersion, nil
+		},
+		PollOptions{
+			Description: fmt.Sprintf("install %s to run PHP %s", installID, phpVersion),
+			Interval:    c.pollInterval,
+		},
+	)
 }
 
-func (c *ApiClient) DeleteInstall(installID string) error {
-	installEndpoint := fmt.Sprintf("%s/installs/%s", baseURL, installID)
+// CopyInstall starts copying the files, database or both of one install to
+// another. The copy runs asynchronously; use WaitForInstallCopy to wait for
+// it to finish.
+func (c *ApiClient) CopyInstall(ctx context.Context, sourceInstallID string, copyData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/installs/%s/copy", sourceInstallID),
+		body:   copyData,
+		expect: []int{http.StatusAccepted, http.StatusCreated, http.StatusOK},
+	})
+}
 
-	req, err := http.NewRequest("DELETE", installEndpoint, nil)
-	if err != nil {
-		return err
-	}
+func (c *ApiClient) GetInstallCopy(ctx context.Context, sourceInstallID, copyID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/installs/%s/copy/%s", sourceInstallID, copyID),
+	})
+}
 
-	_, err = c.doRequest(req)
-	return err
+// WaitForInstallCopy polls a copy until it has completed, returning an error
+// if it fails or the context expires first.
+func (c *ApiClient) WaitForInstallCopy(ctx context.Context, sourceInstallID, copyID string) (map[string]interface{}, error) {
+	return Poll(ctx,
+		func(ctx context.Context) (map[string]interface{}, error) {
+			return c.GetInstallCopy(ctx, sourceInstallID, copyID)
+		},
+		jobCompleted,
+		PollOptions{
+			Description: fmt.Sprintf("copy %s of install %s", copyID, sourceInstallID),
+			Interval:    c.pollInterval,
+		},
+	)
 }
 
 // end install
@@ -524,91 +877,52 @@ func (c *ApiClient) DeleteInstall(installID string) error {
 // site
 // #############################################################################
 
-func (c *ApiClient) GetSite(siteID string) (map[string]interface{}, error) {
-	siteEndpoint := fmt.Sprintf("%s/sites/%s", baseURL, siteID)
-
-	req, err := http.NewRequest("GET", siteEndpoint, nil)
-	if err != nil {
-		return nil, err
+// ListSites returns every site visible to the credentials, limited to a single
+// account when accountID is not empty.
+func (c *ApiClient) ListSites(ctx context.Context, accountID string) ([]map[string]interface{}, error) {
+	query := url.Values{}
+	if accountID != "" {
+		query.Set("account_id", accountID)
 	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var site map[string]interface{}
-	err = json.Unmarshal(body, &site)
-	if err != nil {
-		return nil, err
-	}
-
-	return site, nil
+	return list[map[string]interface{}](ctx, c, "/sites", query)
 }
 
-func (c *ApiClient) CreateSite(siteData map[string]interface{}) (map[string]interface{}, error) {
-	siteEndpoint := fmt.Sprintf("%s/sites", baseURL)
-
-	siteDataBytes, err := json.Marshal(siteData)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", siteEndpoint, bytes.NewBuffer(siteDataBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var site map[string]interface{}
-	err = json.Unmarshal(body, &site)
-	if err != nil {
-		return nil, err
-	}
-
-	return site, nil
+func (c *ApiClient) GetSite(ctx context.Context, siteID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s", siteID),
+	})
 }
 
-func (c *ApiClient) UpdateSite(siteID string, siteData map[string]interface{}) (map[string]interface{}, error) {
-	siteEndpoint := fmt.Sprintf("%s/sites/%s", baseURL, siteID)
-
-	siteDataBytes, err := json.Marshal(siteData)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", siteEndpoint, bytes.NewBuffer(siteDataBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var site map[string]interface{}
-	err = json.Unmarshal(body, &site)
-	if err != nil {
-		return nil, err
-	}
-
-	return site, nil
+func (c *ApiClient) CreateSite(ctx context.Context, siteData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   "/sites",
+		body:   siteData,
+	})
 }
 
-func (c *ApiClient) DeleteSite(siteID string) error {
-	siteEndpoint := fmt.Sprintf("%s/sites/%s", baseURL, siteID)
+func (c *ApiClient) UpdateSite(ctx context.Context, siteID string, siteData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/sites/%s", siteID),
+		body:   siteData,
+	})
+}
 
-	req, err := http.NewRequest("DELETE", siteEndpoint, nil)
-	if err != nil {
-		return err
-	}
+func (c *ApiClient) PatchSite(ctx context.Context, siteID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/sites/%s", siteID),
+		body:   fields,
+	})
+}
 
-	_, err = c.doRequest(req)
+func (c *ApiClient) DeleteSite(ctx context.Context, siteID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/sites/%s", siteID),
+	})
 	return err
 }
 
@@ -618,91 +932,47 @@ func (c *ApiClient) DeleteSite(siteID string) error {
 // ssh_key
 // #############################################################################
 
-func (c *ApiClient) GetSSHKey(sshKeyID string) (map[string]interface{}, error) {
-	sshKeyEndpoint := fmt.Sprintf("%s/ssh_keys/%s", baseURL, sshKeyID)
-
-	req, err := http.NewRequest("GET", sshKeyEndpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var sshKey map[string]interface{}
-	err = json.Unmarshal(body, &sshKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return sshKey, nil
+// ListSSHKeys returns every SSH key registered for the API user.
+func (c *ApiClient) ListSSHKeys(ctx context.Context) ([]map[string]interface{}, error) {
+	return list[map[string]interface{}](ctx, c, "/ssh_keys", nil)
 }
 
-func (c *ApiClient) CreateSSHKey(sshKeyData map[string]interface{}) (map[string]interface{}, error) {
-	sshKeyEndpoint := fmt.Sprintf("%s/ssh_keys", baseURL)
-
-	sshKeyDataBytes, err := json.Marshal(sshKeyData)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", sshKeyEndpoint, bytes.NewBuffer(sshKeyDataBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var sshKey map[string]interface{}
-	err = json.Unmarshal(body, &sshKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return sshKey, nil
+func (c *ApiClient) GetSSHKey(ctx context.Context, sshKeyID string) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/ssh_keys/%s", sshKeyID),
+	})
 }
 
-func (c *ApiClient) UpdateSSHKey(sshKeyID string, sshKeyData map[string]interface{}) (map[string]interface{}, error) {
-	sshKeyEndpoint := fmt.Sprintf("%s/ssh_keys/%s", baseURL, sshKeyID)
-
-	sshKeyDataBytes, err := json.Marshal(sshKeyData)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", sshKeyEndpoint, bytes.NewBuffer(sshKeyDataBytes))
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	var sshKey map[string]interface{}
-	err = json.Unmarshal(body, &sshKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return sshKey, nil
+func (c *ApiClient) CreateSSHKey(ctx context.Context, sshKeyData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPost,
+		path:   "/ssh_keys",
+		body:   sshKeyData,
+	})
 }
 
-func (c *ApiClient) DeleteSSHKey(sshKeyID string) error {
-	sshKeyEndpoint := fmt.Sprintf("%s/ssh_keys/%s", baseURL, sshKeyID)
+func (c *ApiClient) UpdateSSHKey(ctx context.Context, sshKeyID string, sshKeyData map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/ssh_keys/%s", sshKeyID),
+		body:   sshKeyData,
+	})
+}
 
-	req, err := http.NewRequest("DELETE", sshKeyEndpoint, nil)
-	if err != nil {
-		return err
-	}
+func (c *ApiClient) PatchSSHKey(ctx context.Context, sshKeyID string, fields map[string]interface{}) (map[string]interface{}, error) {
+	return do[map[string]interface{}](ctx, c, request{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/ssh_keys/%s", sshKeyID),
+		body:   fields,
+	})
+}
 
-	_, err = c.doRequest(req)
+func (c *ApiClient) DeleteSSHKey(ctx context.Context, sshKeyID string) error {
+	_, err := do[struct{}](ctx, c, request{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/ssh_keys/%s", sshKeyID),
+	})
 	return err
 }
 
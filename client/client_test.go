@@ -0,0 +1,345 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *ApiClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := NewClient("test-user", "test-password", WithPollInterval(time.Millisecond))
+	c.baseURL = server.URL
+	return c
+}
+
+func TestDo(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/installs" {
+			t.Errorf("path = %s, want /installs", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("limit"); got != "10" {
+			t.Errorf("limit = %q, want 10", got)
+		}
+		if user, password, ok := r.BasicAuth(); !ok || user != "test-user" || password != "test-password" {
+			t.Errorf("basic auth = %q, %q, %t", user, password, ok)
+		}
+
+		var payload map[string]string
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil || payload["name"] != "mysite" {
+			t.Errorf("body = %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"abc","name":"mysite"}`))
+	})
+
+	type install struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	got, err := do[install](context.Background(), c, request{
+		method: http.MethodPost,
+		path:   "/installs",
+		query:  url.Values{"limit": {"10"}},
+		body:   map[string]string{"name": "mysite"},
+		expect: []int{http.StatusCreated},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.ID != "abc" || got.Name != "mysite" {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestDoEmptyBody(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := c.DeleteInstall(context.Background(), "abc"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestDoUnexpectedStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	_, err := do[map[string]interface{}](context.Background(), c, request{
+		method: http.MethodPost,
+		path:   "/installs",
+		expect: []int{http.StatusCreated},
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusOK {
+		t.Fatalf("err = %v, want APIError with status 200", err)
+	}
+}
+
+func TestDoErrorStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Not found"}`))
+	})
+
+	_, err := c.GetInstall(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("err = %v, want APIError with status 404", err)
+	}
+	if apiErr.Body != `{"message":"Not found"}` {
+		t.Errorf("body = %q", apiErr.Body)
+	}
+}
+
+func TestDoContextCanceled(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.GetInstall(ctx, "abc"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
+
+func TestPatchSendsOnlyGivenFields(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("method = %s, want PATCH", r.Method)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"php_version":"8.2"}` {
+			t.Errorf("body = %s", body)
+		}
+
+		w.Write([]byte(`{"id":"abc","php_version":"8.2"}`))
+	})
+
+	install, err := c.PatchInstall(context.Background(), "abc", map[string]interface{}{"php_version": "8.2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if install["php_version"] != "8.2" {
+		t.Errorf("got %v", install)
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	cases := map[int]error{
+		http.StatusOK:           nil,
+		http.StatusUnauthorized: ErrInvalidCredentials,
+		http.StatusForbidden:    ErrAPIAccessDisabled,
+	}
+
+	for status, want := range cases {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/user" {
+				t.Errorf("path = %s, want /user", r.URL.Path)
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{}`))
+		})
+
+		err := c.CheckCredentials(context.Background())
+		if want == nil && err != nil {
+			t.Errorf("status %d: unexpected error: %s", status, err)
+		}
+		if want != nil && !errors.Is(err, want) {
+			t.Errorf("status %d: err = %v, want %v", status, err, want)
+		}
+	}
+}
+
+func TestListAccountsPaginates(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("limit"); got != "100" {
+			t.Errorf("limit = %q, want 100", got)
+		}
+
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			w.Write([]byte(`{"next":"/accounts?offset=2","count":3,"results":[{"id":"a"},{"id":"b"}]}`))
+		case "2":
+			w.Write([]byte(`{"next":null,"count":3,"results":[{"id":"c"}]}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	})
+
+	accounts, err := c.ListAccounts(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(accounts) != 3 || accounts[2]["id"] != "c" {
+		t.Errorf("got %v", accounts)
+	}
+}
+
+func TestGetStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status" {
+			t.Errorf("path = %s, want /status", r.URL.Path)
+		}
+		w.Write([]byte(`{"success":true,"created_on":"2018-05-17T19:29:19.191Z"}`))
+	})
+
+	status, err := c.GetStatus(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if status["success"] != true {
+		t.Errorf("got %v", status)
+	}
+}
+
+func TestListInstallsFiltersByAccount(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("account_id"); got != "acct" {
+			t.Errorf("account_id = %q, want acct", got)
+		}
+		w.Write([]byte(`{"next":null,"count":1,"results":[{"id":"abc","name":"mysite"}]}`))
+	})
+
+	installs, err := c.ListInstalls(context.Background(), "acct")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(installs) != 1 || installs[0]["name"] != "mysite" {
+		t.Errorf("got %v", installs)
+	}
+}
+
+func TestWaitForBackup(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/installs/abc/backups/b1" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		calls++
+		if calls < 2 {
+			w.Write([]byte(`{"id":"b1","status":"requested"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"b1","status":"failed"}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	backup, err := c.WaitForBackup(ctx, "abc", "b1")
+	if err == nil {
+		t.Fatal("expected an error for a failed backup")
+	}
+	if backup["status"] != "failed" || calls != 2 {
+		t.Errorf("got %v after %d calls", backup, calls)
+	}
+}
+
+func TestPurgeCache(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/installs/abc/purge_cache" {
+			t.Errorf("%s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"type":"cdn"}` {
+			t.Errorf("body = %s", body)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"id":"p1","status":"pending"}`))
+	})
+
+	purge, err := c.PurgeCache(context.Background(), "abc", "cdn")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if purge["id"] != "p1" || purge["status"] != "pending" {
+		t.Errorf("got %v", purge)
+	}
+}
+
+func TestJobCompleted(t *testing.T) {
+	cases := map[string]struct {
+		done bool
+		err  bool
+	}{
+		"requested":   {false, false},
+		"in_progress": {false, false},
+		"completed":   {true, false},
+		"failed":      {false, true},
+	}
+
+	for status, want := range cases {
+		done, err := jobCompleted(map[string]interface{}{"status": status})
+		if done != want.done || (err != nil) != want.err {
+			t.Errorf("%s: got %t, %v", status, done, err)
+		}
+	}
+}
+
+func TestDomainRedirectTarget(t *testing.T) {
+	target := map[string]interface{}{"id": "example.com", "name": "example.com"}
+	cases := []struct {
+		domain map[string]interface{}
+		want   map[string]interface{}
+	}{
+		{map[string]interface{}{"redirect_to": target}, target},
+		{map[string]interface{}{"redirects_to": []interface{}{target}}, target},
+		{map[string]interface{}{"redirects_to": []interface{}{}}, nil},
+		{map[string]interface{}{"redirect_to": nil}, nil},
+		{map[string]interface{}{}, nil},
+	}
+
+	for _, c := range cases {
+		if got := DomainRedirectTarget(c.domain); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("DomainRedirectTarget(%v) = %v, want %v", c.domain, got, c.want)
+		}
+	}
+}
+
+func TestAccountUserHelpers(t *testing.T) {
+	user := map[string]interface{}{
+		"roles":    "partial, billing,",
+		"installs": []interface{}{map[string]interface{}{"id": "abc", "name": "mysite"}},
+	}
+
+	if roles := AccountUserRolesOf(user); len(roles) != 2 || roles[0] != "partial" || roles[1] != "billing" {
+		t.Errorf("roles = %v", roles)
+	}
+	if !HasRole("partial, billing", "billing") || HasRole("partial,billing", "full") {
+		t.Error("HasRole does not match individual roles")
+	}
+	if !AccountUserHasInstall(user, "abc") || AccountUserHasInstall(user, "xyz") {
+		t.Errorf("install ids = %v", AccountUserInstallIDs(user))
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultPollInterval    = 2 * time.Second
+	defaultPollMaxInterval = 30 * time.Second
+)
+
+// PollOptions controls how Poll waits for an asynchronous operation.
+type PollOptions struct {
+	// Description names the operation in log messages and errors, e.g.
+	// "backup abc123".
+	Description string
+	// Interval is the delay before the second attempt. It doubles after
+	// every attempt up to MaxInterval.
+	Interval    time.Duration
+	MaxInterval time.Duration
+	// Timeout bounds the total time spent polling. Zero means the deadline of
+	// the context passed to Poll, if any, is the only limit.
+	Timeout time.Duration
+}
+
+// Poll calls fetch until done reports true, done returns an error, fetch
+// fails, or the context or timeout expires. The last fetched value is
+// returned in every case so callers can report the final state.
+func Poll[T any](ctx context.Context, fetch func(context.Context) (T, error), done func(T) (bool, error), opts PollOptions) (T, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultPollInterval
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = defaultPollMaxInterval
+	}
+	if opts.MaxInterval < opts.Interval {
+		opts.MaxInterval = opts.Interval
+	}
+	if opts.Description == "" {
+		opts.Description = "operation"
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	var last T
+	interval := opts.Interval
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		result, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, fmt.Errorf("timeout while waiting for %s: %w", opts.Description, ctx.Err())
+			}
+			return last, err
+		}
+		last = result
+
+		finished, err := done(result)
+		if err != nil {
+			return last, fmt.Errorf("%s failed: %w", opts.Description, err)
+		}
+		if finished {
+			tflog.Debug(ctx, "finished waiting for "+opts.Description, map[string]interface{}{
+				"attempts": attempt,
+				"elapsed":  time.Since(start).String(),
+			})
+			return last, nil
+		}
+
+		tflog.Info(ctx, "still waiting for "+opts.Description, map[string]interface{}{
+			"attempt":    attempt,
+			"elapsed":    time.Since(start).String(),
+			"next_check": interval.String(),
+		})
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, fmt.Errorf("timeout while waiting for %s: %w", opts.Description, ctx.Err())
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPoll(t *testing.T) {
+	calls := 0
+	fetch := func(context.Context) (string, error) {
+		calls++
+		if calls < 3 {
+			return "pending", nil
+		}
+		return "completed", nil
+	}
+	done := func(status string) (bool, error) {
+		return status == "completed", nil
+	}
+
+	got, err := Poll(context.Background(), fetch, done, PollOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "completed" || calls != 3 {
+		t.Errorf("got %q after %d calls", got, calls)
+	}
+}
+
+func TestPollPredicateError(t *testing.T) {
+	fetch := func(context.Context) (string, error) {
+		return "failed", nil
+	}
+	done := func(status string) (bool, error) {
+		if status == "failed" {
+			return false, errors.New("backup failed")
+		}
+		return true, nil
+	}
+
+	got, err := Poll(context.Background(), fetch, done, PollOptions{Interval: time.Millisecond})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got != "failed" {
+		t.Errorf("got %q, want the last fetched value", got)
+	}
+}
+
+func TestPollTimeout(t *testing.T) {
+	fetch := func(context.Context) (string, error) {
+		return "pending", nil
+	}
+	done := func(string) (bool, error) {
+		return false, nil
+	}
+
+	_, err := Poll(context.Background(), fetch, done, PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestPollCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	fetch := func(context.Context) (string, error) {
+		cancel()
+		return "pending", nil
+	}
+	done := func(string) (bool, error) {
+		return false, nil
+	}
+
+	_, err := Poll(ctx, fetch, done, PollOptions{Interval: time.Hour})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
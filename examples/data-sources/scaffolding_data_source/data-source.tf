@@ -1,6 +0,0 @@
-# Copyright (c) HashiCorp, Inc.
-# SPDX-License-Identifier: MPL-2.0
-
-data "scaffolding_data_source" "example" {
-  sample_attribute = "foo"
-}
\ No newline at end of file
@@ -0,0 +1,10 @@
+data "wpengine_account_users" "all" {
+  account_id = "28c78b6d-c2da-4f09-85f5-1ad588089b2d"
+}
+
+output "access_review" {
+  value = [
+    for user in data.wpengine_account_users.all.users :
+    "${user.email}: ${join(",", user.roles)} (mfa: ${user.mfa_enabled})"
+  ]
+}
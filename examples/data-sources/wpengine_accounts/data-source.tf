@@ -0,0 +1,7 @@
+data "wpengine_accounts" "agency" {
+  name_regex = "^agency-"
+}
+
+locals {
+  account_ids = { for account in data.wpengine_accounts.agency.accounts : account.name => account.id }
+}
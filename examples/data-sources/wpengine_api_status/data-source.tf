@@ -0,0 +1,10 @@
+data "wpengine_api_status" "current" {}
+
+resource "null_resource" "require_healthy_api" {
+  lifecycle {
+    precondition {
+      condition     = data.wpengine_api_status.current.success
+      error_message = "The WP Engine API is degraded, try again later."
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "wpengine_backups" "latest" {
+  install_id  = "294deacc-d8b8-4005-82c4-0727ba8ddde0"
+  status      = "completed"
+  most_recent = true
+}
+
+output "latest_backup_id" {
+  value = one(data.wpengine_backups.latest.backups[*].id)
+}
@@ -0,0 +1,5 @@
+data "wpengine_current_user" "me" {}
+
+output "api_user_email" {
+  value = data.wpengine_current_user.me.email
+}
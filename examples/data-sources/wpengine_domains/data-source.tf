@@ -0,0 +1,14 @@
+data "wpengine_install" "mysite" {
+  name = "mysite"
+}
+
+data "wpengine_domains" "mysite" {
+  install_id = data.wpengine_install.mysite.id
+}
+
+output "dns_records" {
+  value = {
+    for domain in data.wpengine_domains.mysite.domains :
+    domain.name => domain.cname_target if !domain.duplicate
+  }
+}
@@ -0,0 +1,7 @@
+data "wpengine_install" "mysite" {
+  name = "mysite"
+}
+
+output "mysite_php_version" {
+  value = data.wpengine_install.mysite.php_version
+}
@@ -0,0 +1,8 @@
+data "wpengine_installs" "production" {
+  account_id  = "28c78b6d-c2da-4f09-85f5-1ad588089b2d"
+  environment = "production"
+}
+
+output "production_cnames" {
+  value = { for install in data.wpengine_installs.production.installs : install.name => install.cname }
+}
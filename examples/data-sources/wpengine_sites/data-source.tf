@@ -0,0 +1,10 @@
+data "wpengine_sites" "agency" {
+  account_id = "28c78b6d-c2da-4f09-85f5-1ad588089b2d"
+}
+
+output "site_environments" {
+  value = {
+    for site in data.wpengine_sites.agency.sites :
+    site.name => { for install in site.installs : install.environment => install.name }
+  }
+}
@@ -0,0 +1,7 @@
+data "wpengine_ssh_keys" "company" {
+  comment_contains = "@example.com"
+}
+
+output "registered_key_owners" {
+  value = distinct([for key in data.wpengine_ssh_keys.company.ssh_keys : key.comment])
+}
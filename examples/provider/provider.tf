@@ -2,5 +2,7 @@
 # SPDX-License-Identifier: MPL-2.0
 
 provider "wpengine" {
-  # example configuration here
+  # Defaults to the WPENGINE_USERNAME and WPENGINE_PASSWORD environment variables.
+  username = "api-user-id"
+  password = var.wpengine_api_password
 }
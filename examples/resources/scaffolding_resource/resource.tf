@@ -1,6 +0,0 @@
-# Copyright (c) HashiCorp, Inc.
-# SPDX-License-Identifier: MPL-2.0
-
-resource "wpengine_resource" "example" {
-  sample_attribute = "foo"
-}
@@ -0,0 +1,20 @@
+resource "wpengine_account_users_exclusive" "agency" {
+  account_id = "28c78b6d-c2da-4f09-85f5-1ad588089b2d"
+
+  user {
+    email      = "jane@example.com"
+    first_name = "Jane"
+    last_name  = "Doe"
+    roles      = "full,billing"
+  }
+
+  user {
+    email      = "contractor@example.net"
+    first_name = "Sam"
+    last_name  = "Lee"
+    roles      = "partial"
+  }
+
+  # Break-glass account managed outside of Terraform
+  protected_emails = ["emergency@example.com"]
+}
@@ -0,0 +1,13 @@
+data "wpengine_install" "mysite" {
+  name = "mysite"
+}
+
+resource "wpengine_backup" "before_upgrade" {
+  install_id          = data.wpengine_install.mysite.id
+  description         = "Before WordPress 6.4 upgrade"
+  notification_emails = ["ops@example.com"]
+
+  timeouts {
+    create = "90m"
+  }
+}
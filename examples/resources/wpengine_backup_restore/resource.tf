@@ -0,0 +1,12 @@
+data "wpengine_backups" "last_good" {
+  install_id  = "294deacc-d8b8-4005-82c4-0727ba8ddde0"
+  status      = "completed"
+  most_recent = true
+}
+
+resource "wpengine_backup_restore" "rollback" {
+  install_id             = data.wpengine_backups.last_good.install_id
+  backup_id              = data.wpengine_backups.last_good.backups[0].id
+  confirm_destroy_target = true
+  notification_emails    = ["ops@example.com"]
+}
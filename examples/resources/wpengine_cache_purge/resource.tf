@@ -0,0 +1,12 @@
+variable "deployed_sha" {
+  type = string
+}
+
+resource "wpengine_cache_purge" "after_deploy" {
+  install_id = "294deacc-d8b8-4005-82c4-0727ba8ddde0"
+  type       = "cdn"
+
+  triggers = {
+    deployed_sha = var.deployed_sha
+  }
+}
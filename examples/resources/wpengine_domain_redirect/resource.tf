@@ -0,0 +1,13 @@
+data "wpengine_domains" "mysite" {
+  install_id = "294deacc-d8b8-4005-82c4-0727ba8ddde0"
+}
+
+locals {
+  domain_ids = { for domain in data.wpengine_domains.mysite.domains : domain.name => domain.id }
+}
+
+resource "wpengine_domain_redirect" "www_to_apex" {
+  install_id            = data.wpengine_domains.mysite.install_id
+  domain_id             = local.domain_ids["www.example.com"]
+  redirect_to_domain_id = local.domain_ids["example.com"]
+}
@@ -0,0 +1,15 @@
+# Let's Encrypt
+resource "wpengine_domain_ssl_certificate" "www" {
+  install_id = "294deacc-d8b8-4005-82c4-0727ba8ddde0"
+  domain_id  = "e41fa98f-ea6f-4d3b-a0d5-0fdb3b5b7a3c"
+}
+
+# Custom certificate
+resource "wpengine_domain_ssl_certificate" "shop" {
+  install_id  = "294deacc-d8b8-4005-82c4-0727ba8ddde0"
+  domain_id   = "7b6c2a9e-3f4d-4c1e-9a8b-5d2e1f0c3b4a"
+  type        = "custom"
+  certificate = file("shop.example.com.crt")
+  private_key = file("shop.example.com.key")
+  chain       = file("intermediate.crt")
+}
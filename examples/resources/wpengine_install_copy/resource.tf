@@ -0,0 +1,21 @@
+data "wpengine_install" "staging" {
+  name = "mysitestg"
+}
+
+data "wpengine_install" "production" {
+  name = "mysite"
+}
+
+variable "release" {
+  type = string
+}
+
+resource "wpengine_install_copy" "promote" {
+  source_install_id      = data.wpengine_install.staging.id
+  destination_install_id = data.wpengine_install.production.id
+  type                   = "database"
+
+  triggers = {
+    release = var.release
+  }
+}
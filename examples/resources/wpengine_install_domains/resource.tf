@@ -0,0 +1,13 @@
+resource "wpengine_install_domains" "network" {
+  install_id     = "294deacc-d8b8-4005-82c4-0727ba8ddde0"
+  primary_domain = "example.com"
+
+  domains = [
+    "example.com",
+    "www.example.com",
+    "blog.example.com",
+    "shop.example.com",
+  ]
+
+  max_concurrency = 10
+}
@@ -0,0 +1,10 @@
+data "wpengine_installs" "production" {
+  environment = "production"
+}
+
+resource "wpengine_install_php_version" "fleet" {
+  for_each = { for install in data.wpengine_installs.production.installs : install.name => install.id }
+
+  install_id  = each.value
+  php_version = "8.2"
+}
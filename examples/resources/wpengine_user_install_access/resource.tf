@@ -0,0 +1,16 @@
+data "wpengine_account_users" "contractors" {
+  account_id = "28c78b6d-c2da-4f09-85f5-1ad588089b2d"
+  role       = "partial"
+}
+
+data "wpengine_install" "project" {
+  name = "projectsite"
+}
+
+resource "wpengine_user_install_access" "contractors" {
+  for_each = { for user in data.wpengine_account_users.contractors.users : user.email => user.id }
+
+  account_id = data.wpengine_account_users.contractors.account_id
+  user_id    = each.value
+  install_id = data.wpengine_install.project.id
+}
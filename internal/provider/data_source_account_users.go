@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAccountUsers() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Users with portal access to an account, optionally filtered by role or install access.",
+
+		ReadContext: dataSourceAccountUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "ID of the account.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"role": {
+				Description:      "Only return users holding this role, one of `owner`, `full`, `partial` or `billing`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(client.AccountUserRoles, false)),
+			},
+			"install_id": {
+				Description: "Only return users who can access this install, either through a full or owner role or a partial grant.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"users": {
+				Description: "Matching users.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "Email address of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"first_name": {
+							Description: "First name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_name": {
+							Description: "Last name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"roles": {
+							Description: "Roles the user holds on the account.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"install_ids": {
+							Description: "Installs a partial user has been granted access to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"last_login": {
+							Description: "Time the user last signed in to the portal, if known.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mfa_enabled": {
+							Description: "Whether the user has multi-factor authentication enabled.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"invite_accepted": {
+							Description: "Whether the user has accepted their invitation.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccountUsersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*client.ApiClient)
+
+	accountID := d.Get("account_id").(string)
+	role := d.Get("role").(string)
+	installID := d.Get("install_id").(string)
+
+	users, err := c.ListAccountUsers(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	userList := make([]map[string]interface{}, 0, len(users))
+	for _, user := range users {
+		roles := client.AccountUserRolesOf(user)
+		installIDs := client.AccountUserInstallIDs(user)
+
+		if role != "" && !contains(roles, role) {
+			continue
+		}
+		if installID != "" && !accountUserCanAccess(roles, installIDs, installID) {
+			continue
+		}
+
+		id, _ := user["user_id"].(string)
+		ids = append(ids, id)
+		userList = append(userList, map[string]interface{}{
+			"id":              id,
+			"email":           user["email"],
+			"first_name":      user["first_name"],
+			"last_name":       user["last_name"],
+			"roles":           roles,
+			"install_ids":     installIDs,
+			"last_login":      user["last_login"],
+			"mfa_enabled":     user["mfa_enabled"],
+			"invite_accepted": user["invite_accepted"],
+		})
+	}
+
+	d.SetId(accountID + "/" + listID(ids))
+	if err := d.Set("users", userList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// accountUserCanAccess reports whether a user can reach an install. Owners and
+// full users reach every install on the account; partial users only the ones
+// they have been granted.
+func accountUserCanAccess(roles, installIDs []string, installID string) bool {
+	if contains(roles, "owner") || contains(roles, "full") {
+		return true
+	}
+	return contains(installIDs, installID)
+}
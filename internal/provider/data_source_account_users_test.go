@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceAccountUsers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAccountUsers,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.wpengine_account_users.owners", "users.0.email"),
+					resource.TestCheckResourceAttr("data.wpengine_account_users.owners", "users.0.roles.0", "owner"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccountUserAccess(t *testing.T) {
+	user := map[string]interface{}{
+		"roles":    "partial,billing",
+		"installs": []interface{}{map[string]interface{}{"id": "abc", "name": "mysite"}},
+	}
+
+	roles := client.AccountUserRolesOf(user)
+	installIDs := client.AccountUserInstallIDs(user)
+	if !accountUserCanAccess(roles, installIDs, "abc") {
+		t.Error("partial user should reach an install they were granted")
+	}
+	if accountUserCanAccess(roles, installIDs, "xyz") {
+		t.Error("partial user should not reach other installs")
+	}
+	if !accountUserCanAccess([]string{"full"}, nil, "xyz") {
+		t.Error("full user should reach every install")
+	}
+}
+
+const testAccDataSourceAccountUsers = `
+data "wpengine_current_user" "me" {}
+
+data "wpengine_account_users" "owners" {
+  account_id = data.wpengine_current_user.me.accounts[0].id
+  role       = "owner"
+}
+`
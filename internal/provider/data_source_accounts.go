@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAccounts() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Accounts visible to the provider's API credentials, optionally filtered by name.",
+
+		ReadContext: dataSourceAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Only return the account with exactly this name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_regex": {
+				Description:      "Only return accounts whose name matches this regular expression.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"accounts": {
+				Description: "Matching accounts.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "Any other attributes the API returns for the account, as strings.",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	accounts, err := client.ListAccounts(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	name, filterByName := d.GetOk("name")
+
+	var ids []string
+	accountList := make([]map[string]interface{}, 0, len(accounts))
+	for _, account := range accounts {
+		accountName, _ := account["name"].(string)
+		if filterByName && accountName != name.(string) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(accountName) {
+			continue
+		}
+
+		id, _ := account["id"].(string)
+		ids = append(ids, id)
+		accountList = append(accountList, map[string]interface{}{
+			"id":       id,
+			"name":     accountName,
+			"metadata": stringMap(account, "id", "name"),
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("accounts", accountList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceAccounts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAccounts,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.wpengine_accounts.all", "accounts.0.id"),
+					resource.TestCheckResourceAttr("data.wpengine_accounts.none", "accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAccounts = `
+data "wpengine_accounts" "all" {}
+
+data "wpengine_accounts" "none" {
+  name_regex = "^this-account-does-not-exist$"
+}
+`
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAPIStatus() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Current health of the WP Engine API.",
+
+		ReadContext: dataSourceAPIStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"success": {
+				Description: "Whether the API reports that it is operating normally.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_on": {
+				Description: "Time the status was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceAPIStatusRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	status, err := client.GetStatus(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("status")
+	d.Set("success", status["success"])
+	d.Set("created_on", status["created_on"])
+
+	return diags
+}
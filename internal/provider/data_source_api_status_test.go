@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceAPIStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAPIStatus,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wpengine_api_status.current", "success", "true"),
+					resource.TestCheckResourceAttrSet("data.wpengine_api_status.current", "created_on"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAPIStatus = `
+data "wpengine_api_status" "current" {}
+`
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBackups() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Backups of an install, newest first.",
+
+		ReadContext: dataSourceBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"status": {
+				Description: "Only return backups with this status, e.g. `completed`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"most_recent": {
+				Description: "Only return the newest matching backup.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"backups": {
+				Description: "Matching backups, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the backup.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Description of the backup.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Status of the backup.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "Time the backup was requested.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBackupsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+
+	backups, err := client.ListBackups(ctx, installID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters := map[string]string{}
+	if v, ok := d.GetOk("status"); ok {
+		filters["status"] = v.(string)
+	}
+
+	var matching []map[string]interface{}
+	for _, backup := range backups {
+		if matchesFilters(backup, filters) {
+			matching = append(matching, backup)
+		}
+	}
+
+	sortBackupsNewestFirst(matching)
+	if d.Get("most_recent").(bool) && len(matching) > 1 {
+		if _, ok := backupCreatedAt(matching[0]); !ok {
+			return diag.Errorf("cannot tell which backup of install %s is the most recent: none has a valid created_at", installID)
+		}
+		matching = matching[:1]
+	}
+
+	var ids []string
+	backupList := make([]map[string]interface{}, 0, len(matching))
+	for _, backup := range matching {
+		id, _ := backup["id"].(string)
+		ids = append(ids, id)
+		backupList = append(backupList, map[string]interface{}{
+			"id":          id,
+			"description": backup["description"],
+			"status":      backup["status"],
+			"created_at":  backup["created_at"],
+		})
+	}
+
+	d.SetId(installID + "/" + listID(ids))
+	if err := d.Set("backups", backupList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// sortBackupsNewestFirst orders backups by created_at, newest first. The
+// timestamps are compared as times rather than strings since the API mixes
+// precisions and offsets. Backups without a valid created_at go last.
+func sortBackupsNewestFirst(backups []map[string]interface{}) {
+	sort.SliceStable(backups, func(i, j int) bool {
+		a, aOK := backupCreatedAt(backups[i])
+		b, bOK := backupCreatedAt(backups[j])
+		if aOK != bOK {
+			return aOK
+		}
+		return a.After(b)
+	})
+}
+
+func backupCreatedAt(backup map[string]interface{}) (time.Time, bool) {
+	createdAt, _ := backup["created_at"].(string)
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	return t, err == nil
+}
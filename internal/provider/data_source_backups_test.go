@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSortBackupsNewestFirst(t *testing.T) {
+	backups := []map[string]interface{}{
+		{"id": "seconds", "created_at": "2024-05-01T10:00:00Z"},
+		{"id": "missing"},
+		{"id": "millis", "created_at": "2024-05-01T10:00:00.5Z"},
+		{"id": "offset", "created_at": "2024-05-01T11:30:00+02:00"},
+		{"id": "invalid", "created_at": "yesterday"},
+		{"id": "nanos", "created_at": "2024-05-01T10:00:00.123456789Z"},
+	}
+
+	sortBackupsNewestFirst(backups)
+
+	var got []string
+	for _, backup := range backups {
+		got = append(got, backup["id"].(string))
+	}
+	// 11:30+02:00 is 09:30Z, older than every backup taken at 10:00Z, even
+	// though it would come first comparing the strings
+	want := []string{"millis", "nanos", "seconds", "offset", "missing", "invalid"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAccDataSourceBackups(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceBackups,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wpengine_backups.latest", "backups.#", "1"),
+					resource.TestCheckResourceAttr("data.wpengine_backups.latest", "backups.0.status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceBackups = `
+data "wpengine_installs" "all" {}
+
+data "wpengine_backups" "latest" {
+  install_id  = data.wpengine_installs.all.installs[0].id
+  status      = "completed"
+  most_recent = true
+}
+`
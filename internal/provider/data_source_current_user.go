@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCurrentUser() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Account user the provider's API credentials belong to, and the accounts it can access.",
+
+		ReadContext: dataSourceCurrentUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "Email address of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"first_name": {
+				Description: "First name of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_name": {
+				Description: "Last name of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Full name of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"phone_number": {
+				Description: "Phone number of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"accounts": {
+				Description: "Accounts the credentials can access.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCurrentUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, _ := user["id"].(string)
+	firstName, _ := user["first_name"].(string)
+	lastName, _ := user["last_name"].(string)
+
+	d.SetId(id)
+	d.Set("email", user["email"])
+	d.Set("first_name", firstName)
+	d.Set("last_name", lastName)
+	d.Set("name", strings.TrimSpace(firstName+" "+lastName))
+	d.Set("phone_number", user["phone_number"])
+
+	// The API embeds the accounts the user can access in the user object
+	accounts, _ := user["accounts"].([]interface{})
+	accountList := make([]map[string]interface{}, 0, len(accounts))
+	for _, raw := range accounts {
+		account, _ := raw.(map[string]interface{})
+		accountList = append(accountList, map[string]interface{}{
+			"id":   account["id"],
+			"name": account["name"],
+		})
+	}
+	if err := d.Set("accounts", accountList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceCurrentUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCurrentUser,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.wpengine_current_user.me", "id"),
+					resource.TestMatchResourceAttr(
+						"data.wpengine_current_user.me", "email", regexp.MustCompile("@")),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceCurrentUser = `
+data "wpengine_current_user" "me" {}
+`
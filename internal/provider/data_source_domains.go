@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDomains() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Domains attached to an install, with the DNS targets they should point at.",
+
+		ReadContext: dataSourceDomainsRead,
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"domains": {
+				Description: "Domains attached to the install.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the domain.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Domain name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"primary": {
+							Description: "Whether this is the primary domain of the install.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"duplicate": {
+							Description: "Whether the domain is a duplicate of a domain on another install.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"redirect_to": {
+							Description: "Name of the domain this domain redirects to, if any.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cname_target": {
+							Description: "Host name a CNAME record for the domain should point at.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"a_targets": {
+							Description: "Addresses A records for the domain should point at. Empty unless the install has stable IPs.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+
+	// Every domain on an install shares the install's DNS targets.
+	install, err := c.GetInstall(ctx, installID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stableIPs, _ := install["stable_ips"].([]interface{})
+
+	domains, err := c.ListDomains(ctx, installID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domainList := make([]map[string]interface{}, 0, len(domains))
+	for _, domain := range domains {
+		redirectTo, _ := client.DomainRedirectTarget(domain)["name"].(string)
+		domainList = append(domainList, map[string]interface{}{
+			"id":           domain["id"],
+			"name":         domain["name"],
+			"primary":      domain["primary"],
+			"duplicate":    domain["duplicate"],
+			"redirect_to":  redirectTo,
+			"cname_target": install["cname"],
+			"a_targets":    stableIPs,
+		})
+	}
+
+	d.SetId(installID)
+	if err := d.Set("domains", domainList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
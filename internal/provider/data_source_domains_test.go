@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDomains(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDomains,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.wpengine_domains.first", "domains.0.name"),
+					resource.TestCheckResourceAttrPair(
+						"data.wpengine_domains.first", "domains.0.cname_target",
+						"data.wpengine_installs.all", "installs.0.cname"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceDomains = `
+data "wpengine_installs" "all" {}
+
+data "wpengine_domains" "first" {
+  install_id = data.wpengine_installs.all.installs[0].id
+}
+`
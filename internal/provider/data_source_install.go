@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceInstall() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "A single install, looked up by ID or by its name.",
+
+		ReadContext: dataSourceInstallRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "ID of the install. Exactly one of `id` or `name` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "Name of the install, e.g. `mysite`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"account_id": {
+				Description: "ID of the account the install belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site_id": {
+				Description: "ID of the site the install belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"environment": {
+				Description: "Environment of the install.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the install.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"php_version": {
+				Description: "PHP version the install runs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cname": {
+				Description: "CNAME target for the install.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"primary_domain": {
+				Description: "Primary domain of the install.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_multisite": {
+				Description: "Whether the install is a WordPress multisite.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"stable_ips": {
+				Description: "Stable IP addresses assigned to the install, if any.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceInstallRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	var install map[string]interface{}
+	if id, ok := d.GetOk("id"); ok {
+		var err error
+		install, err = client.GetInstall(ctx, id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		name := d.Get("name").(string)
+
+		// Install names are globally unique but the API can only look installs
+		// up by ID, so search the full list.
+		installs, err := client.ListInstalls(ctx, "")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, candidate := range installs {
+			if candidate["name"] == name {
+				install = candidate
+				break
+			}
+		}
+		if install == nil {
+			return diag.FromErr(fmt.Errorf("no install named %q is visible to these credentials", name))
+		}
+	}
+
+	id, _ := install["id"].(string)
+	d.SetId(id)
+	d.Set("name", install["name"])
+	d.Set("account_id", nestedID(install, "account"))
+	d.Set("site_id", nestedID(install, "site"))
+	d.Set("environment", install["environment"])
+	d.Set("status", install["status"])
+	d.Set("php_version", install["php_version"])
+	d.Set("cname", install["cname"])
+	d.Set("primary_domain", install["primary_domain"])
+	d.Set("is_multisite", install["is_multisite"])
+	if err := d.Set("stable_ips", install["stable_ips"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
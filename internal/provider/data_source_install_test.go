@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceInstall(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceInstall,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.wpengine_install.by_name", "id",
+						"data.wpengine_installs.all", "installs.0.id"),
+					resource.TestCheckResourceAttrPair(
+						"data.wpengine_install.by_id", "name",
+						"data.wpengine_installs.all", "installs.0.name"),
+					resource.TestCheckResourceAttrSet("data.wpengine_install.by_id", "cname"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceInstall = `
+data "wpengine_installs" "all" {}
+
+data "wpengine_install" "by_name" {
+  name = data.wpengine_installs.all.installs[0].name
+}
+
+data "wpengine_install" "by_id" {
+  id = data.wpengine_installs.all.installs[0].id
+}
+`
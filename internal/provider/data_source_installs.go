@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var installEnvironments = []string{"production", "staging", "development"}
+
+func dataSourceInstalls() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Installs visible to the provider's API credentials, optionally filtered.",
+
+		ReadContext: dataSourceInstallsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "Only return installs on this account.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"site_id": {
+				Description: "Only return installs belonging to this site.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"environment": {
+				Description:      "Only return installs in this environment, one of `production`, `staging` or `development`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(installEnvironments, false)),
+			},
+			"name_regex": {
+				Description:      "Only return installs whose name matches this regular expression.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"status": {
+				Description: "Only return installs with this status, e.g. `active`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"php_version": {
+				Description: "Only return installs running this PHP version, e.g. `8.2`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"installs": {
+				Description: "Matching installs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the install.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the install.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"account_id": {
+							Description: "ID of the account the install belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"site_id": {
+							Description: "ID of the site the install belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"environment": {
+							Description: "Environment of the install.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cname": {
+							Description: "CNAME target for the install.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"primary_domain": {
+							Description: "Primary domain of the install.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Status of the install.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"php_version": {
+							Description: "PHP version the install runs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstallsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	installs, err := client.ListInstalls(ctx, d.Get("account_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	// Filters that map directly onto a string attribute of the install.
+	filters := map[string]string{}
+	for _, key := range []string{"environment", "status", "php_version"} {
+		if v, ok := d.GetOk(key); ok {
+			filters[key] = v.(string)
+		}
+	}
+	siteID := d.Get("site_id").(string)
+
+	var ids []string
+	installList := make([]map[string]interface{}, 0, len(installs))
+	for _, install := range installs {
+		if !matchesFilters(install, filters) {
+			continue
+		}
+		if siteID != "" && nestedID(install, "site") != siteID {
+			continue
+		}
+		name, _ := install["name"].(string)
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+
+		id, _ := install["id"].(string)
+		ids = append(ids, id)
+		installList = append(installList, map[string]interface{}{
+			"id":             id,
+			"name":           name,
+			"account_id":     nestedID(install, "account"),
+			"site_id":        nestedID(install, "site"),
+			"environment":    install["environment"],
+			"cname":          install["cname"],
+			"primary_domain": install["primary_domain"],
+			"status":         install["status"],
+			"php_version":    install["php_version"],
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("installs", installList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceInstalls(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceInstalls,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.wpengine_installs.production", "id"),
+					resource.TestCheckResourceAttr("data.wpengine_installs.none", "installs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceInstalls = `
+data "wpengine_installs" "production" {
+  environment = "production"
+}
+
+data "wpengine_installs" "none" {
+  name_regex = "^this-install-does-not-exist$"
+}
+`
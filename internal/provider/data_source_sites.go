@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSites() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "Sites visible to the provider's API credentials, with the installs of each site.",
+
+		ReadContext: dataSourceSitesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "Only return sites on this account.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sites": {
+				Description: "Matching sites.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the site.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the site.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"account_id": {
+							Description: "ID of the account the site belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"installs": {
+							Description: "Environment installs of the site.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "ID of the install.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"name": {
+										Description: "Name of the install.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"environment": {
+										Description: "Environment of the install.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"cname": {
+										Description: "CNAME target for the install.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"php_version": {
+										Description: "PHP version the install runs.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	sites, err := client.ListSites(ctx, d.Get("account_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	siteList := make([]map[string]interface{}, 0, len(sites))
+	for _, site := range sites {
+		id, _ := site["id"].(string)
+
+		// The list endpoint normally embeds the installs of each site; fetch the
+		// site itself when it does not.
+		installs, ok := site["installs"].([]interface{})
+		if !ok {
+			full, err := client.GetSite(ctx, id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			installs, _ = full["installs"].([]interface{})
+		}
+
+		installList := make([]map[string]interface{}, 0, len(installs))
+		for _, raw := range installs {
+			install, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			installList = append(installList, map[string]interface{}{
+				"id":          install["id"],
+				"name":        install["name"],
+				"environment": install["environment"],
+				"cname":       install["cname"],
+				"php_version": install["php_version"],
+			})
+		}
+
+		ids = append(ids, id)
+		siteList = append(siteList, map[string]interface{}{
+			"id":         id,
+			"name":       site["name"],
+			"account_id": nestedID(site, "account"),
+			"installs":   installList,
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("sites", siteList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
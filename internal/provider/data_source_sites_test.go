@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSites(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSites,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.wpengine_sites.all", "sites.0.id"),
+					resource.TestCheckResourceAttrSet("data.wpengine_sites.all", "sites.0.installs.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSites = `
+data "wpengine_sites" "all" {}
+`
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		// This description is used by the documentation generator and the language server.
+		Description: "SSH keys registered with WP Engine, optionally filtered by comment.",
+
+		ReadContext: dataSourceSSHKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment_contains": {
+				Description: "Only return keys whose comment contains this substring.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ssh_keys": {
+				Description: "Matching SSH keys.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"comment": {
+							Description: "Comment of the key, usually the owner's email address.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"fingerprint": {
+							Description: "Fingerprint of the key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "Time the key was registered.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSSHKeysRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.(*client.ApiClient)
+
+	keys, err := client.ListSSHKeys(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	commentContains := d.Get("comment_contains").(string)
+
+	var ids []string
+	keyList := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		comment, _ := key["comment"].(string)
+		if !strings.Contains(comment, commentContains) {
+			continue
+		}
+
+		// SSH keys are identified by "uuid" rather than "id" in the API.
+		id, _ := key["uuid"].(string)
+		if id == "" {
+			id, _ = key["id"].(string)
+		}
+
+		ids = append(ids, id)
+		keyList = append(keyList, map[string]interface{}{
+			"id":          id,
+			"comment":     comment,
+			"fingerprint": key["fingerprint"],
+			"created_at":  key["created_at"],
+		})
+	}
+
+	d.SetId(listID(ids))
+	if err := d.Set("ssh_keys", keyList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSSHKeys(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSSHKeys,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.wpengine_ssh_keys.all", "id"),
+					resource.TestCheckResourceAttr("data.wpengine_ssh_keys.none", "ssh_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSSHKeys = `
+data "wpengine_ssh_keys" "all" {}
+
+data "wpengine_ssh_keys" "none" {
+  comment_contains = "this-comment-does-not-exist"
+}
+`
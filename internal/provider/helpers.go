@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// listID derives a stable ID for a list data source from the IDs of the
+// objects it returned.
+func listID(ids []string) string {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+
+	sum := sha1.Sum([]byte(strings.Join(sorted, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+// stringMap converts the values of an API object to strings so they can be
+// stored in a TypeMap attribute. Nested objects are JSON encoded and the keys
+// in skip are left out.
+func stringMap(object map[string]interface{}, skip ...string) map[string]string {
+	result := map[string]string{}
+
+	for key, value := range object {
+		if value == nil || contains(skip, key) {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			result[key] = v
+		case map[string]interface{}, []interface{}:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			result[key] = string(encoded)
+		default:
+			result[key] = fmt.Sprint(v)
+		}
+	}
+
+	return result
+}
+
+// nestedID returns the ID of a related object embedded in an API response,
+// such as the account or site of an install.
+func nestedID(object map[string]interface{}, key string) string {
+	related, _ := object[key].(map[string]interface{})
+	id, _ := related["id"].(string)
+	return id
+}
+
+// matchesFilters reports whether every key in filters holds the same string
+// value in object.
+func matchesFilters(object map[string]interface{}, filters map[string]string) bool {
+	for key, want := range filters {
+		if got, _ := object[key].(string); got != want {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListIDIsOrderIndependent(t *testing.T) {
+	if listID([]string{"a", "b"}) != listID([]string{"b", "a"}) {
+		t.Error("listID should not depend on the order of the IDs")
+	}
+	if listID([]string{"a"}) == listID([]string{"b"}) {
+		t.Error("listID should differ for different IDs")
+	}
+}
+
+func TestStringMap(t *testing.T) {
+	got := stringMap(map[string]interface{}{
+		"id":      "abc",
+		"name":    "agency",
+		"active":  true,
+		"count":   float64(3),
+		"owner":   map[string]interface{}{"id": "xyz"},
+		"deleted": nil,
+	}, "id")
+
+	want := map[string]string{
+		"name":   "agency",
+		"active": "true",
+		"count":  "3",
+		"owner":  `{"id":"xyz"}`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -4,10 +4,23 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/drzln/terraform-provider-wpengine/resource/account_user"
+	"github.com/drzln/terraform-provider-wpengine/resource/account_users_exclusive"
+	"github.com/drzln/terraform-provider-wpengine/resource/backup"
+	"github.com/drzln/terraform-provider-wpengine/resource/backup_restore"
+	"github.com/drzln/terraform-provider-wpengine/resource/cache_purge"
+	"github.com/drzln/terraform-provider-wpengine/resource/domain_redirect"
+	"github.com/drzln/terraform-provider-wpengine/resource/domain_ssl_certificate"
+	"github.com/drzln/terraform-provider-wpengine/resource/install_copy"
+	"github.com/drzln/terraform-provider-wpengine/resource/install_domains"
+	"github.com/drzln/terraform-provider-wpengine/resource/install_php_version"
+	"github.com/drzln/terraform-provider-wpengine/resource/user_install_access"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -29,20 +42,64 @@ func init() {
 func New(version string) func() *schema.Provider {
 	return func() *schema.Provider {
 		p := &schema.Provider{
+			Schema: map[string]*schema.Schema{
+				"username": {
+					Description: "WP Engine API username. Can also be set with the `WPENGINE_USERNAME` environment variable.",
+					Type:        schema.TypeString,
+					Required:    true,
+					DefaultFunc: schema.EnvDefaultFunc("WPENGINE_USERNAME", nil),
+				},
+				"password": {
+					Description: "WP Engine API password. Can also be set with the `WPENGINE_PASSWORD` environment variable.",
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("WPENGINE_PASSWORD", nil),
+				},
+				"skip_credentials_validation": {
+					Description: "Skip checking the credentials against the API when the provider is configured, e.g. for offline runs.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"check_api_status": {
+					Description: "Query the API status endpoint when the provider is configured and warn if the API reports it is degraded.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"wpengine_data_source": dataSourceScaffolding(),
+				"wpengine_account_users": dataSourceAccountUsers(),
+				"wpengine_accounts":      dataSourceAccounts(),
+				"wpengine_api_status":    dataSourceAPIStatus(),
+				"wpengine_backups":       dataSourceBackups(),
+				"wpengine_current_user":  dataSourceCurrentUser(),
+				"wpengine_domains":       dataSourceDomains(),
+				"wpengine_install":       dataSourceInstall(),
+				"wpengine_installs":      dataSourceInstalls(),
+				"wpengine_sites":         dataSourceSites(),
+				"wpengine_ssh_keys":      dataSourceSSHKeys(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				// "wpengine_account":      resourceWPEngineAccount(),
-				"wpengine_account_user": resourceWPEngineAccountUser(),
+				"wpengine_account_user":            account_user.ResourceWPEngineAccountUser(),
+				"wpengine_account_users_exclusive": account_users_exclusive.ResourceWPEngineAccountUsersExclusive(),
+				"wpengine_user_install_access":     user_install_access.ResourceWPEngineUserInstallAccess(),
 				// "wpengine_site":         resourceWPEngineSite(),
 				// "wpengine_install":      resourceWPEngineInstall(),
+				"wpengine_install_copy":        install_copy.ResourceWPEngineInstallCopy(),
+				"wpengine_install_domains":     install_domains.ResourceWPEngineInstallDomains(),
+				"wpengine_install_php_version": install_php_version.ResourceWPEngineInstallPHPVersion(),
 				// "wpengine_domain":       resourceWPEngineDomain(),
+				"wpengine_domain_redirect":        domain_redirect.ResourceWPEngineDomainRedirect(),
+				"wpengine_domain_ssl_certificate": domain_ssl_certificate.ResourceWPEngineDomainSSLCertificate(),
 				// "wpengine_ssh_key":      resourceWPEngineSshKey(),
 				// "wpengine_cdn":          resourceWPEngineCdn(),
 				// potentially unCRUDable
-				// "wpengine_cache": resourceWPEngineCache(),
-				// "wpengine_backup": resourceWPEngineBackup(),
+				"wpengine_cache_purge":    cache_purge.ResourceWPEngineCachePurge(),
+				"wpengine_backup":         backup.ResourceWPEngineBackup(),
+				"wpengine_backup_restore": backup_restore.ResourceWPEngineBackupRestore(),
 			},
 		}
 
@@ -66,19 +123,86 @@ func New(version string) func() *schema.Provider {
 
 // end resourceWPEngineAccount
 
-
 // ############################################################################
 // config
 // ############################################################################
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (any, diag.Diagnostics) {
-	return func(context.Context, *schema.ResourceData) (any, diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 		// Setup a User-Agent for your API client (replace the provider name for yours):
 		// userAgent := p.UserAgent("terraform-provider-wpengine", version)
 		// TODO: myClient.UserAgent = userAgent
 
-		return &client.ApiClient{}, nil
+		var diags diag.Diagnostics
+
+		c := client.NewClient(d.Get("username").(string), d.Get("password").(string))
+
+		if d.Get("check_api_status").(bool) {
+			diags = append(diags, statusDiagnostics(ctx, c)...)
+		}
+
+		if d.Get("skip_credentials_validation").(bool) {
+			return c, diags
+		}
+
+		if err := c.CheckCredentials(ctx); err != nil {
+			return nil, append(diags, credentialsDiagnostics(err)...)
+		}
+
+		return c, diags
 	}
 }
 
+// statusDiagnostics warns when the API status endpoint is unreachable or
+// reports that the API is degraded. It never fails configuration on its own.
+func statusDiagnostics(ctx context.Context, c *client.ApiClient) diag.Diagnostics {
+	status, err := c.GetStatus(ctx)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to check WP Engine API status",
+			Detail:   err.Error(),
+		}}
+	}
+
+	if success, _ := status["success"].(bool); !success {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "WP Engine API is degraded",
+			Detail:   fmt.Sprintf("The API status endpoint did not report success (last updated %v). Requests may fail or be slow.", status["created_on"]),
+		}}
+	}
+
+	return nil
+}
+
+// credentialsDiagnostics turns a failed credential check into an error the
+// user can act on without digging through status codes.
+func credentialsDiagnostics(err error) diag.Diagnostics {
+	var urlErr *url.Error
+
+	switch {
+	case errors.Is(err, client.ErrInvalidCredentials):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid WP Engine API credentials",
+			Detail:   "The API rejected the configured username and password. Check the `username` and `password` provider arguments or the WPENGINE_USERNAME and WPENGINE_PASSWORD environment variables.\n\n" + err.Error(),
+		}}
+	case errors.Is(err, client.ErrAPIAccessDisabled):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "WP Engine API access is disabled",
+			Detail:   "The credentials are valid but API access is not enabled for the account. Enable it in the WP Engine User Portal.\n\n" + err.Error(),
+		}}
+	case errors.As(err, &urlErr):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to reach the WP Engine API",
+			Detail:   "The credentials could not be checked because of a network error. Set `skip_credentials_validation = true` to configure the provider without contacting the API.\n\n" + err.Error(),
+		}}
+	}
+
+	return diag.Errorf("unable to validate WP Engine API credentials: %s", err)
+}
+
 // end config
@@ -4,8 +4,13 @@
 package provider
 
 import (
+	"fmt"
+	"net/url"
+	"os"
 	"testing"
+	"time"
 
+	"github.com/drzln/terraform-provider-wpengine/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,7 +18,7 @@ import (
 // The factory function will be invoked for every Terraform CLI command executed
 // to create a provider server to which the CLI can reattach.
 var providerFactories = map[string]func() (*schema.Provider, error){
-	"scaffolding": func() (*schema.Provider, error) {
+	"wpengine": func() (*schema.Provider, error) {
 		return New("dev")(), nil
 	},
 }
@@ -25,7 +30,41 @@ func TestProvider(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	for _, env := range []string{"WPENGINE_USERNAME", "WPENGINE_PASSWORD"} {
+		if os.Getenv(env) == "" {
+			t.Fatalf("%s must be set for acceptance tests", env)
+		}
+	}
+}
+
+func TestProviderResourcesDeclareTimeouts(t *testing.T) {
+	for name, r := range New("dev")().ResourcesMap {
+		if r.Timeouts == nil {
+			t.Errorf("%s: no Timeouts declared", name)
+			continue
+		}
+		for _, timeout := range []*time.Duration{r.Timeouts.Create, r.Timeouts.Read, r.Timeouts.Delete} {
+			if timeout == nil {
+				t.Errorf("%s: missing default create, read or delete timeout", name)
+			}
+		}
+		if r.UpdateContext != nil && r.Timeouts.Update == nil {
+			t.Errorf("%s: missing default update timeout", name)
+		}
+	}
+}
+
+func TestCredentialsDiagnostics(t *testing.T) {
+	cases := map[string]error{
+		"Invalid WP Engine API credentials": fmt.Errorf("%w: status 401", client.ErrInvalidCredentials),
+		"WP Engine API access is disabled":  fmt.Errorf("%w: status 403", client.ErrAPIAccessDisabled),
+		"Unable to reach the WP Engine API": &url.Error{Op: "Get", URL: "https://api.wpengineapi.com/v1/user", Err: fmt.Errorf("connection refused")},
+	}
+
+	for summary, err := range cases {
+		diags := credentialsDiagnostics(err)
+		if len(diags) != 1 || diags[0].Summary != summary {
+			t.Errorf("%v: got %+v, want summary %q", err, diags, summary)
+		}
+	}
 }
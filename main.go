@@ -6,8 +6,8 @@ package main
 import (
 	"flag"
 
+	"github.com/drzln/terraform-provider-wpengine/internal/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
-	"github.com/hashicorp/terraform-provider-wpengine/internal/provider"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -2,6 +2,7 @@ package account_user
 
 import (
 	"context"
+	"time"
 
 	"github.com/drzln/terraform-provider-wpengine/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,12 +13,47 @@ import (
 // resourceWPEngineAccountUser
 // #############################################################################
 
-func resourceWPEngineAccountUser() *schema.Resource {
+func ResourceWPEngineAccountUser() *schema.Resource {
 	return &schema.Resource{
+		Description: "Manages a user on a WP Engine account.",
+
 		CreateContext: resourceWPEngineAccountUserCreate,
-		// ReadContext:   resourceWPEngineAccountUserRead,
-		// UpdateContext: resourceWPEngineAccountUserUpdate,
-		// DeleteContext: resourceWPEngineAccountUserDelete,
+		ReadContext:   resourceWPEngineAccountUserRead,
+		UpdateContext: resourceWPEngineAccountUserUpdate,
+		DeleteContext: resourceWPEngineAccountUserDelete,
+
+		// The SDK applies these as deadlines on the context handed to each
+		// CRUD function, so client calls and pollers inherit them.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "ID of the account the user belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"first_name": {
+				Description: "First name of the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"last_name": {
+				Description: "Last name of the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"email": {
+				Description: "Email address of the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
 	}
 }
 
@@ -33,7 +69,7 @@ func resourceWPEngineAccountUserCreate(ctx context.Context, d *schema.ResourceDa
 		"email":      d.Get("email").(string),
 	}
 
-	user, err := client.CreateAccountUser(accountID, userData)
+	user, err := client.CreateAccountUser(ctx, accountID, userData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -51,7 +87,7 @@ func resourceWPEngineAccountUserRead(ctx context.Context, d *schema.ResourceData
 	userID := d.Id()
 
 	// Call the client method to get the user details
-	user, err := client.GetAccountUser(userID)
+	user, err := client.GetAccountUser(ctx, userID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -71,16 +107,18 @@ func resourceWPEngineAccountUserUpdate(ctx context.Context, d *schema.ResourceDa
 
 	userID := d.Id()
 
-	// Check which fields have changed
-	if d.HasChanges("first_name", "last_name", "email") {
-		userData := map[string]interface{}{
-			"first_name": d.Get("first_name").(string),
-			"last_name":  d.Get("last_name").(string),
-			"email":      d.Get("email").(string),
+	// Only send the fields that have changed so attributes managed outside of
+	// Terraform are left alone
+	userData := map[string]interface{}{}
+	for _, field := range []string{"first_name", "last_name", "email"} {
+		if d.HasChange(field) {
+			userData[field] = d.Get(field).(string)
 		}
+	}
 
+	if len(userData) > 0 {
 		// Call the client method to update the user details
-		_, err := client.UpdateAccountUser(userID, userData)
+		_, err := client.PatchAccountUser(ctx, userID, userData)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -97,7 +135,7 @@ func resourceWPEngineAccountUserDelete(ctx context.Context, d *schema.ResourceDa
 
 	userID := d.Id()
 
-	err := client.DeleteAccountUser(userID)
+	err := client.DeleteAccountUser(ctx, userID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,366 @@
+package account_users_exclusive
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// #############################################################################
+// resourceWPEngineAccountUsersExclusive
+// #############################################################################
+
+func ResourceWPEngineAccountUsersExclusive() *schema.Resource {
+	return &schema.Resource{
+		Description: "Authoritatively manages who has portal access to an account. Missing users are invited and unlisted users are removed, except owners, the API user itself and `protected_emails`. Destroying the resource leaves every user in place.",
+
+		CreateContext: resourceWPEngineAccountUsersExclusiveCreate,
+		ReadContext:   resourceWPEngineAccountUsersExclusiveRead,
+		UpdateContext: resourceWPEngineAccountUsersExclusiveUpdate,
+		DeleteContext: resourceWPEngineAccountUsersExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWPEngineAccountUsersExclusiveImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "ID of the account.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user": {
+				Description: "A user who should have access to the account.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Description: "Email address of the user.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"roles": {
+							Description:      "Comma separated roles of the user, each one of `owner`, `full`, `partial` or `billing`, e.g. `full,billing`.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateRoles,
+						},
+						"first_name": {
+							Description: "First name used when inviting the user.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"last_name": {
+							Description: "Last name used when inviting the user.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"protected_emails": {
+				Description: "Email addresses that are never removed, even when not listed in `user`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_ids": {
+				Description: "IDs of the managed users, keyed by email address.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceWPEngineAccountUsersExclusiveCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("account_id").(string))
+
+	diags := reconcileUsers(ctx, d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceWPEngineAccountUsersExclusiveRead(ctx, d, m)...)
+}
+
+func resourceWPEngineAccountUsersExclusiveRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	users, err := c.ListAccountUsers(ctx, d.Id())
+	if client.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Names are only used for invitations, so keep whatever was configured
+	configured := map[string]map[string]interface{}{}
+	for _, raw := range d.Get("user").(*schema.Set).List() {
+		user := raw.(map[string]interface{})
+		configured[strings.ToLower(user["email"].(string))] = user
+	}
+	protected, err := keptEmails(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var userList []map[string]interface{}
+	userIDs := map[string]string{}
+	for _, user := range users {
+		email, _ := user["email"].(string)
+		roles, _ := user["roles"].(string)
+		key := strings.ToLower(email)
+
+		want, listed := configured[key]
+
+		// Users that are never removed only show up in state when they are
+		// listed, otherwise they would be a permanent diff
+		if !listed && (protected[key] || client.HasRole(roles, "owner")) {
+			continue
+		}
+
+		entry := map[string]interface{}{
+			"email":      email,
+			"roles":      normalizeRoles(roles),
+			"first_name": "",
+			"last_name":  "",
+		}
+		if listed {
+			entry["email"] = want["email"]
+			entry["first_name"] = want["first_name"]
+			entry["last_name"] = want["last_name"]
+			if normalizeRoles(want["roles"].(string)) == normalizeRoles(roles) {
+				entry["roles"] = want["roles"]
+			}
+		}
+
+		// Key the ID by the email as it appears in the user entry, i.e. as
+		// configured, so the two can be matched up regardless of case
+		userList = append(userList, entry)
+		userIDs[entry["email"].(string)], _ = user["user_id"].(string)
+	}
+
+	d.Set("account_id", d.Id())
+	if err := d.Set("user", userList); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("user_ids", userIDs)
+
+	return diags
+}
+
+func resourceWPEngineAccountUsersExclusiveUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChanges("user", "protected_emails") {
+		diags = reconcileUsers(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceWPEngineAccountUsersExclusiveRead(ctx, d, m)...)
+}
+
+func resourceWPEngineAccountUsersExclusiveDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Removing everyone from the account on destroy would lock the account
+	// out, so users are left as they are
+	d.SetId("")
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Account users were not removed",
+		Detail:   "Destroying wpengine_account_users_exclusive only stops Terraform from managing account membership. Every user keeps their current access.",
+	}}
+}
+
+func resourceWPEngineAccountUsersExclusiveImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("account_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// reconcileUsers invites missing users, corrects roles and removes users that
+// are not listed. Owners are never removed or demoted, and keptEmails are
+// never removed.
+func reconcileUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	accountID := d.Get("account_id").(string)
+
+	users, err := c.ListAccountUsers(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	protected, err := keptEmails(ctx, c, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := map[string]map[string]interface{}{}
+	for _, user := range users {
+		email, _ := user["email"].(string)
+		current[strings.ToLower(email)] = user
+	}
+
+	desired := map[string]map[string]interface{}{}
+	for _, raw := range d.Get("user").(*schema.Set).List() {
+		user := raw.(map[string]interface{})
+		desired[strings.ToLower(user["email"].(string))] = user
+	}
+
+	// Check the owner safeguard up front so nothing changes when it trips
+	for key, want := range desired {
+		have, ok := current[key]
+		if !ok {
+			continue
+		}
+		roles, _ := have["roles"].(string)
+		if client.HasRole(roles, "owner") && !client.HasRole(want["roles"].(string), "owner") {
+			return diag.Errorf("refusing to change the roles of account owner %s; transfer ownership in the User Portal first", want["email"])
+		}
+	}
+
+	for _, key := range sortedKeys(desired) {
+		want := desired[key]
+		have, ok := current[key]
+
+		if !ok {
+			_, err := c.CreateAccountUser(ctx, accountID, map[string]interface{}{
+				"first_name": want["first_name"].(string),
+				"last_name":  want["last_name"].(string),
+				"email":      want["email"].(string),
+				"roles":      want["roles"].(string),
+			})
+			if err != nil {
+				return append(diags, diag.FromErr(fmt.Errorf("inviting %s: %w", want["email"], err))...)
+			}
+			continue
+		}
+
+		roles, _ := have["roles"].(string)
+		if normalizeRoles(roles) != normalizeRoles(want["roles"].(string)) {
+			userID, _ := have["user_id"].(string)
+			_, err := c.PatchAccountUserForAccount(ctx, accountID, userID, map[string]interface{}{
+				"roles": want["roles"].(string),
+			})
+			if err != nil {
+				return append(diags, diag.FromErr(fmt.Errorf("updating roles of %s: %w", want["email"], err))...)
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(current) {
+		if _, ok := desired[key]; ok || protected[key] {
+			continue
+		}
+
+		have := current[key]
+		roles, _ := have["roles"].(string)
+		if client.HasRole(roles, "owner") {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Account owner was not removed",
+				Detail:   fmt.Sprintf("%s is an owner of the account and is not listed. Owners are never removed by Terraform.", have["email"]),
+			})
+			continue
+		}
+
+		userID, _ := have["user_id"].(string)
+		err := c.DeleteAccountUserForAccount(ctx, accountID, userID)
+		if err != nil && !client.IsNotFound(err) {
+			return append(diags, diag.FromErr(fmt.Errorf("removing %s: %w", have["email"], err))...)
+		}
+	}
+
+	return diags
+}
+
+// keptEmails returns the lower case email addresses that are never removed:
+// protected_emails plus the user the provider's credentials belong to.
+func keptEmails(ctx context.Context, c *client.ApiClient, d *schema.ResourceData) (map[string]bool, error) {
+	kept := map[string]bool{}
+	for _, email := range d.Get("protected_emails").(*schema.Set).List() {
+		kept[strings.ToLower(email.(string))] = true
+	}
+
+	me, err := c.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if email, ok := me["email"].(string); ok {
+		kept[strings.ToLower(email)] = true
+	}
+
+	return kept, nil
+}
+
+// validateRoles checks every role in a comma separated roles string on its
+// own, so any order or combination the API accepts is allowed.
+func validateRoles(v interface{}, path cty.Path) diag.Diagnostics {
+	roles := client.SplitRoles(v.(string))
+	if len(roles) == 0 {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "No roles given",
+			Detail:        fmt.Sprintf("Expected one or more of %s.", strings.Join(client.AccountUserRoles, ", ")),
+			AttributePath: path,
+		}}
+	}
+
+	var diags diag.Diagnostics
+	for _, role := range roles {
+		if !client.IsAccountUserRole(role) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Unknown role",
+				Detail:        fmt.Sprintf("%q is not one of %s.", role, strings.Join(client.AccountUserRoles, ", ")),
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
+
+// normalizeRoles sorts the comma separated roles string the API returns so
+// "billing,full" and "full,billing" compare equal.
+func normalizeRoles(roles string) string {
+	parts := client.SplitRoles(roles)
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func sortedKeys(users map[string]map[string]interface{}) []string {
+	keys := make([]string, 0, len(users))
+	for key := range users {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// end resourceWPEngineAccountUsersExclusive
@@ -0,0 +1,180 @@
+package account_users_exclusive
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNormalizeRoles(t *testing.T) {
+	for roles, want := range map[string]string{
+		"full,billing":    "billing,full",
+		"billing, full":   "billing,full",
+		"partial":         "partial",
+		"":                "",
+		"owner,,billing,": "billing,owner",
+	} {
+		if got := normalizeRoles(roles); got != want {
+			t.Errorf("normalizeRoles(%q) = %q, want %q", roles, got, want)
+		}
+	}
+}
+
+func TestValidateRoles(t *testing.T) {
+	for roles, wantErr := range map[string]bool{
+		"full":            false,
+		"full,billing":    false,
+		"billing,full":    false,
+		"billing":         false,
+		"partial,billing": false,
+		"":                true,
+		"admin":           true,
+		"full,admin":      true,
+	} {
+		if diags := validateRoles(roles, nil); diags.HasError() != wantErr {
+			t.Errorf("validateRoles(%q) = %v, want error %t", roles, diags, wantErr)
+		}
+	}
+}
+
+// accountUsersServer serves a fixed set of account users for account "acct"
+// and records every request that would change them.
+type accountUsersServer struct {
+	users  []map[string]interface{}
+	writes []string
+}
+
+func (s *accountUsersServer) client(t *testing.T) *client.ApiClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/user":
+			w.Write([]byte(`{"email":"API@example.com"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acct/account_users":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"next":    nil,
+				"count":   len(s.users),
+				"results": s.users,
+			})
+		case strings.HasPrefix(r.URL.Path, "/accounts/acct/account_users"):
+			body, _ := io.ReadAll(r.Body)
+			s.writes = append(s.writes, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(body)))
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return client.NewClient("user", "password", client.WithBaseURL(server.URL))
+}
+
+func testResourceData(t *testing.T, users []interface{}, protected ...interface{}) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineAccountUsersExclusive().Schema, map[string]interface{}{
+		"account_id":       "acct",
+		"user":             users,
+		"protected_emails": protected,
+	})
+	d.SetId("acct")
+	return d
+}
+
+func testUser(email, roles string) map[string]interface{} {
+	return map[string]interface{}{
+		"email":      email,
+		"roles":      roles,
+		"first_name": "",
+		"last_name":  "",
+	}
+}
+
+func TestReconcileUsers(t *testing.T) {
+	s := &accountUsersServer{users: []map[string]interface{}{
+		{"user_id": "owner", "email": "owner@example.com", "roles": "owner"},
+		{"user_id": "api", "email": "api@example.com", "roles": "full"},
+		{"user_id": "kept", "email": "Kept@example.com", "roles": "partial"},
+		{"user_id": "gone", "email": "gone@example.com", "roles": "full"},
+		{"user_id": "stay", "email": "stay@example.com", "roles": "full,billing"},
+		{"user_id": "demoted", "email": "demoted@example.com", "roles": "full"},
+	}}
+	c := s.client(t)
+
+	d := testResourceData(t, []interface{}{
+		testUser("stay@example.com", "billing,full"),
+		testUser("demoted@example.com", "partial"),
+		testUser("new@example.com", "billing"),
+	}, "kept@example.com")
+
+	diags := reconcileUsers(context.Background(), d, c)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := []string{
+		`PATCH /accounts/acct/account_users/demoted {"roles":"partial"}`,
+		`POST /accounts/acct/account_users {"email":"new@example.com","first_name":"","last_name":"","roles":"billing"}`,
+		`DELETE /accounts/acct/account_users/gone`,
+	}
+	if strings.Join(s.writes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("writes =\n%s\nwant\n%s", strings.Join(s.writes, "\n"), strings.Join(want, "\n"))
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "owner@example.com") {
+		t.Errorf("diags = %v, want a warning about the unlisted owner", diags)
+	}
+}
+
+func TestReconcileUsersRefusesOwnerDemotion(t *testing.T) {
+	s := &accountUsersServer{users: []map[string]interface{}{
+		{"user_id": "owner", "email": "owner@example.com", "roles": "owner"},
+		{"user_id": "gone", "email": "gone@example.com", "roles": "full"},
+	}}
+	c := s.client(t)
+
+	d := testResourceData(t, []interface{}{
+		testUser("owner@example.com", "full"),
+		testUser("new@example.com", "full"),
+	})
+
+	if diags := reconcileUsers(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected an error when demoting an owner")
+	}
+	if len(s.writes) != 0 {
+		t.Errorf("writes = %v, want none before the owner check", s.writes)
+	}
+}
+
+func TestReadKeysUserIDsByConfiguredEmail(t *testing.T) {
+	s := &accountUsersServer{users: []map[string]interface{}{
+		{"user_id": "stay", "email": "Stay@Example.com", "roles": "full"},
+		{"user_id": "other", "email": "Other@example.com", "roles": "partial"},
+	}}
+	c := s.client(t)
+
+	d := testResourceData(t, []interface{}{
+		testUser("stay@example.com", "full"),
+	})
+
+	if diags := resourceWPEngineAccountUsersExclusiveRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := map[string]interface{}{
+		"stay@example.com":  "stay",
+		"Other@example.com": "other",
+	}
+	if got := d.Get("user_ids").(map[string]interface{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("user_ids = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,124 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// #############################################################################
+// resourceWPEngineBackup
+// #############################################################################
+
+func ResourceWPEngineBackup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Takes a backup of an install and waits for it to complete. Backups cannot be deleted through the API, so destroying this resource only removes it from state.",
+
+		CreateContext: resourceWPEngineBackupCreate,
+		ReadContext:   resourceWPEngineBackupRead,
+		DeleteContext: resourceWPEngineBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install to back up.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "Description of the backup.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"notification_emails": {
+				Description: "Email addresses to notify when the backup completes.",
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Description: "Status of the backup.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWPEngineBackupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+	backupData := map[string]interface{}{
+		"description":         d.Get("description").(string),
+		"notification_emails": d.Get("notification_emails").([]interface{}),
+	}
+
+	backup, err := c.CreateBackup(ctx, installID, backupData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Record the backup straight away so a failed or timed out wait still
+	// leaves it in state
+	backupID, _ := backup["id"].(string)
+	if backupID == "" {
+		return diag.Errorf("backup of install %s was requested but the API returned no backup ID", installID)
+	}
+	d.SetId(backupID)
+
+	backup, err = c.WaitForBackup(ctx, installID, backupID)
+	if backup != nil {
+		d.Set("status", backup["status"])
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceWPEngineBackupRead(ctx, d, m)
+}
+
+func resourceWPEngineBackupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	// Backups expire after a while. Keep the resource in state regardless,
+	// otherwise Terraform would take a new backup on the next apply.
+	backup, err := c.GetBackup(ctx, d.Get("install_id").(string), d.Id())
+	if client.IsNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("status", backup["status"])
+
+	return diags
+}
+
+func resourceWPEngineBackupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Backups expire on their own and the API offers no way to remove them
+	d.SetId("")
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Backup was not deleted",
+		Detail:   "WP Engine backups cannot be deleted through the API. The backup has been removed from Terraform state but still exists on the install.",
+	}}
+}
+
+// end resourceWPEngineBackup
@@ -0,0 +1,133 @@
+package backup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceWPEngineBackup().Schema, map[string]interface{}{
+		"install_id":          "abc",
+		"description":         "before upgrade",
+		"notification_emails": []interface{}{"ops@example.com"},
+	})
+}
+
+func TestCreateWaitsForBackup(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"b1","status":"requested"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/abc/backups/b1":
+			polls++
+			if polls < 2 {
+				w.Write([]byte(`{"id":"b1","status":"in_progress"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"b1","status":"completed"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := testResourceData(t)
+
+	if diags := resourceWPEngineBackupCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+	if d.Id() != "b1" {
+		t.Errorf("id = %q, want b1", d.Id())
+	}
+	if got := d.Get("status"); got != "completed" {
+		t.Errorf("status = %v, want completed", got)
+	}
+	if polls < 2 {
+		t.Errorf("polled %d times, want the wait to continue until the backup completes", polls)
+	}
+}
+
+func TestCreateKeepsIDWhenBackupFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"b1","status":"requested"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/abc/backups/b1":
+			w.Write([]byte(`{"id":"b1","status":"failed"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := testResourceData(t)
+
+	if diags := resourceWPEngineBackupCreate(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected an error when the backup fails")
+	}
+	if d.Id() != "b1" {
+		t.Errorf("id = %q, want the failed backup kept in state", d.Id())
+	}
+	if got := d.Get("status"); got != "failed" {
+		t.Errorf("status = %v, want failed", got)
+	}
+}
+
+func TestCreateRejectsMissingBackupID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups" {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"status":"requested"}`))
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := testResourceData(t)
+
+	if diags := resourceWPEngineBackupCreate(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected an error when the API returns no backup ID")
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the resource left out of state", d.Id())
+	}
+}
+
+func TestReadKeepsExpiredBackup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	d := testResourceData(t)
+	d.SetId("b1")
+	d.Set("status", "completed")
+
+	if diags := resourceWPEngineBackupRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	if d.Id() != "b1" {
+		t.Errorf("id = %q, want the expired backup kept in state", d.Id())
+	}
+	if got := d.Get("status"); got != "completed" {
+		t.Errorf("status = %v, want the last known status", got)
+	}
+}
@@ -0,0 +1,163 @@
+package backup_restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// #############################################################################
+// resourceWPEngineBackupRestore
+// #############################################################################
+
+func ResourceWPEngineBackupRestore() *schema.Resource {
+	return &schema.Resource{
+		Description: "Restores a backup onto its install, overwriting the install's files and database. A backup of the current state is taken first. Destroying the resource does not undo the restore.",
+
+		CreateContext: resourceWPEngineBackupRestoreCreate,
+		ReadContext:   resourceWPEngineBackupRestoreRead,
+		DeleteContext: resourceWPEngineBackupRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install to restore.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_id": {
+				Description: "ID of the backup to restore.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"confirm_destroy_target": {
+				Description: "Must be set to `true` to acknowledge that the install's current files and database will be overwritten.",
+				Type:        schema.TypeBool,
+				Required:    true,
+				ForceNew:    true,
+				ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
+					if !v.(bool) {
+						return diag.Diagnostics{{
+							Severity:      diag.Error,
+							Summary:       "Restore not confirmed",
+							Detail:        "Restoring a backup overwrites the install. Set confirm_destroy_target = true to proceed.",
+							AttributePath: path,
+						}}
+					}
+					return nil
+				},
+			},
+			"notification_emails": {
+				Description: "Email addresses to notify about the pre-restore backup and the restore.",
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"pre_restore_backup_id": {
+				Description: "ID of the backup taken of the install just before the restore.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the restore.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWPEngineBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	if !d.Get("confirm_destroy_target").(bool) {
+		return diag.Errorf("confirm_destroy_target must be true to restore a backup")
+	}
+
+	installID := d.Get("install_id").(string)
+	backupID := d.Get("backup_id").(string)
+	emails := d.Get("notification_emails").([]interface{})
+
+	// Take a backup of the current state first so the restore can be undone
+	preRestore, err := c.CreateBackup(ctx, installID, map[string]interface{}{
+		"description":         fmt.Sprintf("Automatic backup before restoring backup %s", backupID),
+		"notification_emails": emails,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("taking pre-restore backup: %w", err))
+	}
+
+	preRestoreID, _ := preRestore["id"].(string)
+	if preRestoreID == "" {
+		return diag.Errorf("pre-restore backup of install %s was requested but the API returned no backup ID", installID)
+	}
+	if _, err := c.WaitForBackup(ctx, installID, preRestoreID); err != nil {
+		return diag.FromErr(fmt.Errorf("taking pre-restore backup %s: %w", preRestoreID, err))
+	}
+
+	restore, err := c.RestoreBackup(ctx, installID, backupID, map[string]interface{}{
+		"notification_emails": emails,
+	})
+	if err != nil {
+		// Nothing is in state yet, so name the safety backup here or it is lost
+		return diag.FromErr(fmt.Errorf("restoring backup %s (pre-restore backup %s was taken): %w", backupID, preRestoreID, err))
+	}
+
+	// Record the restore straight away so a failed or timed out wait still
+	// leaves it, and the pre-restore backup, in state
+	restoreID, _ := restore["id"].(string)
+	if restoreID == "" {
+		return diag.Errorf("restore of backup %s was started but the API returned no restore ID (pre-restore backup %s was taken)", backupID, preRestoreID)
+	}
+	d.SetId(restoreID)
+	d.Set("pre_restore_backup_id", preRestoreID)
+
+	restore, err = c.WaitForRestore(ctx, installID, restoreID)
+	if restore != nil {
+		d.Set("status", restore["status"])
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWPEngineBackupRestoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	// Restore records may expire once the restore is done. Keep the resource in
+	// state regardless, otherwise Terraform would restore the backup again.
+	restore, err := c.GetRestore(ctx, d.Get("install_id").(string), d.Id())
+	if client.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("status", restore["status"])
+
+	return nil
+}
+
+func resourceWPEngineBackupRestoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// end resourceWPEngineBackupRestore
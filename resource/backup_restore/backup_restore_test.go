@@ -0,0 +1,120 @@
+package backup_restore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCreateSkipsRestoreWhenPreRestoreBackupFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"pre1","status":"requested"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/abc/backups/pre1":
+			w.Write([]byte(`{"id":"pre1","status":"failed"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineBackupRestore().Schema, map[string]interface{}{
+		"install_id":             "abc",
+		"backup_id":              "b1",
+		"confirm_destroy_target": true,
+		"notification_emails":    []interface{}{"ops@example.com"},
+	})
+
+	diags := resourceWPEngineBackupRestoreCreate(context.Background(), d, c)
+	if !diags.HasError() {
+		t.Fatal("expected an error when the pre-restore backup fails")
+	}
+	if !strings.Contains(diags[0].Summary, "pre1") {
+		t.Errorf("error does not name the pre-restore backup: %s", diags[0].Summary)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the resource left out of state", d.Id())
+	}
+}
+
+func TestCreateReportsPreRestoreBackupWhenRestoreFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"pre1","status":"requested"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/abc/backups/pre1":
+			w.Write([]byte(`{"id":"pre1","status":"completed"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups/b1/restore":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message":"restore already running"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineBackupRestore().Schema, map[string]interface{}{
+		"install_id":             "abc",
+		"backup_id":              "b1",
+		"confirm_destroy_target": true,
+		"notification_emails":    []interface{}{"ops@example.com"},
+	})
+
+	diags := resourceWPEngineBackupRestoreCreate(context.Background(), d, c)
+	if !diags.HasError() {
+		t.Fatal("expected an error when the restore cannot be started")
+	}
+	if !strings.Contains(diags[0].Summary, "pre1") {
+		t.Errorf("error does not name the pre-restore backup: %s", diags[0].Summary)
+	}
+}
+
+func TestCreateRejectsMissingRestoreID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"pre1","status":"requested"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/abc/backups/pre1":
+			w.Write([]byte(`{"id":"pre1","status":"completed"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/backups/b1/restore":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"status":"requested"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineBackupRestore().Schema, map[string]interface{}{
+		"install_id":             "abc",
+		"backup_id":              "b1",
+		"confirm_destroy_target": true,
+	})
+
+	diags := resourceWPEngineBackupRestoreCreate(context.Background(), d, c)
+	if !diags.HasError() {
+		t.Fatal("expected an error when the API returns no restore ID")
+	}
+	if !strings.Contains(diags[0].Summary, "pre1") {
+		t.Errorf("error does not name the pre-restore backup: %s", diags[0].Summary)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the resource left out of state", d.Id())
+	}
+}
@@ -0,0 +1,109 @@
+package cache_purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// #############################################################################
+// resourceWPEngineCachePurge
+// #############################################################################
+
+func ResourceWPEngineCachePurge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Purges a cache of an install when created and again whenever `triggers` changes. Destroying the resource does nothing.",
+
+		CreateContext: resourceWPEngineCachePurgeCreate,
+		ReadContext:   resourceWPEngineCachePurgeRead,
+		DeleteContext: resourceWPEngineCachePurgeDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install whose cache is purged.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:      "Cache to purge, one of `object`, `page` or `cdn`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "page",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"object", "page", "cdn"}, false)),
+			},
+			"triggers": {
+				Description: "Arbitrary values that cause the cache to be purged again when they change, e.g. a deployed commit SHA.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"purged_at": {
+				Description: "Time the purge was accepted by the API.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"purge_id": {
+				Description: "ID the API assigned to the purge, if it reported one.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the purge as reported by the API, if any.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWPEngineCachePurgeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := m.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+	cacheType := d.Get("type").(string)
+
+	purge, err := client.PurgeCache(ctx, installID, cacheType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	purgedAt := time.Now().UTC()
+	d.SetId(fmt.Sprintf("%s/%s/%d", installID, cacheType, purgedAt.Unix()))
+	d.Set("purged_at", purgedAt.Format(time.RFC3339))
+
+	// The API may answer without a body, in which case these stay empty
+	purgeID, _ := purge["id"].(string)
+	status, _ := purge["status"].(string)
+	d.Set("purge_id", purgeID)
+	d.Set("status", status)
+
+	return diags
+}
+
+func resourceWPEngineCachePurgeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// A purge is a one-off action with nothing to read back
+	return nil
+}
+
+func resourceWPEngineCachePurgeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// end resourceWPEngineCachePurge
@@ -0,0 +1,77 @@
+package cache_purge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCreatePurgesConfiguredCache(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/installs/abc/purge_cache" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"id":"p1","status":"requested"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineCachePurge().Schema, map[string]interface{}{
+		"install_id": "abc",
+		"type":       "object",
+	})
+
+	if diags := resourceWPEngineCachePurgeCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+	if body["type"] != "object" {
+		t.Errorf("purged cache type %v, want object", body["type"])
+	}
+	if !strings.HasPrefix(d.Id(), "abc/object/") {
+		t.Errorf("id = %q, want it prefixed with abc/object/", d.Id())
+	}
+	if got := d.Get("purge_id"); got != "p1" {
+		t.Errorf("purge_id = %v, want p1", got)
+	}
+	if got := d.Get("status"); got != "requested" {
+		t.Errorf("status = %v, want requested", got)
+	}
+	if d.Get("purged_at") == "" {
+		t.Error("purged_at not set")
+	}
+}
+
+func TestCreateAcceptsEmptyResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineCachePurge().Schema, map[string]interface{}{
+		"install_id": "abc",
+	})
+
+	if diags := resourceWPEngineCachePurgeCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+	if d.Id() == "" {
+		t.Error("purge not recorded in state")
+	}
+	if got := d.Get("purge_id"); got != "" {
+		t.Errorf("purge_id = %v, want it empty", got)
+	}
+}
@@ -0,0 +1,145 @@
+package domain_redirect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// #############################################################################
+// resourceWPEngineDomainRedirect
+// #############################################################################
+
+func ResourceWPEngineDomainRedirect() *schema.Resource {
+	return &schema.Resource{
+		Description: "Redirects one domain on an install to another. Only the redirect is managed, so the domains themselves can be owned elsewhere.",
+
+		CreateContext: resourceWPEngineDomainRedirectCreate,
+		ReadContext:   resourceWPEngineDomainRedirectRead,
+		UpdateContext: resourceWPEngineDomainRedirectUpdate,
+		DeleteContext: resourceWPEngineDomainRedirectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWPEngineDomainRedirectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install both domains are attached to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domain_id": {
+				Description: "ID of the domain that redirects, e.g. `www.example.com`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"redirect_to_domain_id": {
+				Description: "ID of the domain to redirect to, e.g. `example.com`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceWPEngineDomainRedirectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+	domainID := d.Get("domain_id").(string)
+
+	_, err := c.PatchInstallDomain(ctx, installID, domainID, map[string]interface{}{
+		"redirect_to": d.Get("redirect_to_domain_id").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(installID + "/" + domainID)
+
+	return resourceWPEngineDomainRedirectRead(ctx, d, m)
+}
+
+func resourceWPEngineDomainRedirectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	domain, err := c.GetInstallDomain(ctx, d.Get("install_id").(string), d.Get("domain_id").(string))
+	if client.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A redirect removed outside of Terraform shows up as an empty target and
+	// is put back on the next apply
+	targetID, _ := client.DomainRedirectTarget(domain)["id"].(string)
+	d.Set("redirect_to_domain_id", targetID)
+
+	return diags
+}
+
+func resourceWPEngineDomainRedirectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	if d.HasChange("redirect_to_domain_id") {
+		_, err := c.PatchInstallDomain(ctx, d.Get("install_id").(string), d.Get("domain_id").(string), map[string]interface{}{
+			"redirect_to": d.Get("redirect_to_domain_id").(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWPEngineDomainRedirectRead(ctx, d, m)
+}
+
+func resourceWPEngineDomainRedirectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	// Clearing the redirect leaves the domain itself in place
+	_, err := c.PatchInstallDomain(ctx, d.Get("install_id").(string), d.Get("domain_id").(string), map[string]interface{}{
+		"redirect_to": nil,
+	})
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceWPEngineDomainRedirectImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	installID, domainID, ok := strings.Cut(d.Id(), "/")
+	if !ok || installID == "" || domainID == "" {
+		return nil, fmt.Errorf("expected an ID of the form <install_id>/<domain_id>, got %q", d.Id())
+	}
+
+	d.Set("install_id", installID)
+	d.Set("domain_id", domainID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// end resourceWPEngineDomainRedirect
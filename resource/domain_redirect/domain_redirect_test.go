@@ -0,0 +1,136 @@
+package domain_redirect
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceWPEngineDomainRedirect().Schema, map[string]interface{}{
+		"install_id":            "abc",
+		"domain_id":             "www.example.com",
+		"redirect_to_domain_id": "example.com",
+	})
+}
+
+func TestCreateSetsRedirectOnly(t *testing.T) {
+	var patched []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/installs/abc/domains/www.example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			patched = append(patched, body)
+			w.Write([]byte(`{"id":"www.example.com"}`))
+		case http.MethodGet:
+			w.Write([]byte(`{"id":"www.example.com","redirect_to":{"id":"example.com","name":"example.com"}}`))
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	d := testResourceData(t)
+
+	if diags := resourceWPEngineDomainRedirectCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+
+	want := []map[string]interface{}{{"redirect_to": "example.com"}}
+	if !reflect.DeepEqual(patched, want) {
+		t.Errorf("patched %v, want %v", patched, want)
+	}
+	if d.Id() != "abc/www.example.com" {
+		t.Errorf("id = %q, want abc/www.example.com", d.Id())
+	}
+}
+
+func TestReadRedirectTargetShapes(t *testing.T) {
+	cases := map[string]string{
+		`{"id":"www.example.com","redirect_to":{"id":"example.com","name":"example.com"}}`:    "example.com",
+		`{"id":"www.example.com","redirects_to":[{"id":"example.com","name":"example.com"}]}`: "example.com",
+		`{"id":"www.example.com","redirect_to":null}`:                                         "",
+	}
+
+	for response, want := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(response))
+		}))
+
+		c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+		d := testResourceData(t)
+		d.SetId("abc/www.example.com")
+
+		if diags := resourceWPEngineDomainRedirectRead(context.Background(), d, c); diags.HasError() {
+			t.Fatalf("read: %v", diags)
+		}
+		if got := d.Get("redirect_to_domain_id"); got != want {
+			t.Errorf("%s: redirect_to_domain_id = %v, want %q", response, got, want)
+		}
+		server.Close()
+	}
+}
+
+func TestReadRemovesMissingDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	d := testResourceData(t)
+	d.SetId("abc/www.example.com")
+
+	if diags := resourceWPEngineDomainRedirectRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the resource removed from state", d.Id())
+	}
+}
+
+func TestDeleteClearsRedirect(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/installs/abc/domains/www.example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.Write([]byte(`{"id":"www.example.com"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	d := testResourceData(t)
+	d.SetId("abc/www.example.com")
+
+	if diags := resourceWPEngineDomainRedirectDelete(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("delete: %v", diags)
+	}
+
+	want := map[string]interface{}{"redirect_to": nil}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("sent %v, want %v", body, want)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the resource removed from state", d.Id())
+	}
+}
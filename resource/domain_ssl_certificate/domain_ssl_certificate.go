@@ -0,0 +1,259 @@
+package domain_ssl_certificate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// #############################################################################
+// resourceWPEngineDomainSSLCertificate
+// #############################################################################
+
+func ResourceWPEngineDomainSSLCertificate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the SSL certificate of a domain on an install, either issued by Let's Encrypt or uploaded.",
+
+		CreateContext: resourceWPEngineDomainSSLCertificateCreate,
+		ReadContext:   resourceWPEngineDomainSSLCertificateRead,
+		UpdateContext: resourceWPEngineDomainSSLCertificateUpdate,
+		DeleteContext: resourceWPEngineDomainSSLCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWPEngineDomainSSLCertificateImport,
+		},
+
+		CustomizeDiff: resourceWPEngineDomainSSLCertificateCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install the domain is attached to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domain_id": {
+				Description: "ID of the domain.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:      "Where the certificate comes from, `letsencrypt` or `custom`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "letsencrypt",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"letsencrypt", "custom"}, false)),
+			},
+			"certificate": {
+				Description: "PEM encoded certificate. Required when `type` is `custom`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"private_key": {
+				Description: "PEM encoded private key of the certificate. Required when `type` is `custom`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"chain": {
+				Description: "PEM encoded intermediate certificates.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description: "Status of the certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"issuer": {
+				Description: "Issuer of the certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expires_at": {
+				Description: "Time the certificate expires.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWPEngineDomainSSLCertificateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	custom := d.Get("type").(string) == "custom"
+
+	// Values that are only known after apply, e.g. from another resource,
+	// are checked again by the API
+	for _, field := range []string{"certificate", "private_key"} {
+		if custom && d.NewValueKnown(field) && d.Get(field).(string) == "" {
+			return fmt.Errorf("%s is required when type is custom", field)
+		}
+	}
+	for _, field := range []string{"certificate", "private_key", "chain"} {
+		if !custom && (!d.NewValueKnown(field) || d.Get(field).(string) != "") {
+			return fmt.Errorf("%s can only be set when type is custom", field)
+		}
+	}
+
+	return nil
+}
+
+func resourceWPEngineDomainSSLCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	installID := d.Get("install_id").(string)
+	domainID := d.Get("domain_id").(string)
+
+	if diags := setCertificate(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	// Record the certificate before waiting so a failed or timed out wait
+	// still leaves it in state
+	d.SetId(installID + "/" + domainID)
+
+	if diags := waitForCertificate(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceWPEngineDomainSSLCertificateRead(ctx, d, m)
+}
+
+func resourceWPEngineDomainSSLCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	certificate, err := c.GetSSLCertificate(ctx, d.Get("install_id").(string), d.Get("domain_id").(string))
+	if client.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API never returns the private key, so it is kept from configuration
+	setCertificateType(d, certificate)
+	d.Set("status", certificate["status"])
+	d.Set("issuer", certificate["issuer"])
+	d.Set("expires_at", certificate["expires_at"])
+
+	return diags
+}
+
+func resourceWPEngineDomainSSLCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Uploading again replaces the certificate in place
+	if d.HasChanges("certificate", "private_key", "chain") {
+		if diags := setCertificate(ctx, d, m); diags.HasError() {
+			return diags
+		}
+		if diags := waitForCertificate(ctx, d, m); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceWPEngineDomainSSLCertificateRead(ctx, d, m)
+}
+
+func resourceWPEngineDomainSSLCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	err := c.DeleteSSLCertificate(ctx, d.Get("install_id").(string), d.Get("domain_id").(string))
+	if err != nil && !client.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceWPEngineDomainSSLCertificateImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	installID, domainID, ok := strings.Cut(d.Id(), "/")
+	if !ok || installID == "" || domainID == "" {
+		return nil, fmt.Errorf("expected an ID of the form <install_id>/<domain_id>, got %q", d.Id())
+	}
+
+	d.Set("install_id", installID)
+	d.Set("domain_id", domainID)
+
+	c := m.(*client.ApiClient)
+
+	certificate, err := c.GetSSLCertificate(ctx, installID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	setCertificateType(d, certificate)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setCertificateType records where the certificate comes from when the API
+// reports it, so a certificate switched outside of Terraform shows up as a
+// change.
+func setCertificateType(d *schema.ResourceData, certificate map[string]interface{}) {
+	if certificateType, ok := certificate["type"].(string); ok && certificateType != "" {
+		d.Set("type", certificateType)
+	}
+}
+
+// setCertificate requests or uploads the configured certificate. Use
+// waitForCertificate to wait for it to become active.
+func setCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+	domainID := d.Get("domain_id").(string)
+
+	certificateData := map[string]interface{}{
+		"type": d.Get("type").(string),
+	}
+	if d.Get("type").(string) == "custom" {
+		certificateData["certificate"] = d.Get("certificate").(string)
+		certificateData["private_key"] = d.Get("private_key").(string)
+		if chain := d.Get("chain").(string); chain != "" {
+			certificateData["chain"] = chain
+		}
+	}
+
+	_, err := c.SetSSLCertificate(ctx, installID, domainID, certificateData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// waitForCertificate waits for the certificate of the domain to become
+// active, recording its last known status either way.
+func waitForCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	certificate, err := c.WaitForSSLCertificate(ctx, d.Get("install_id").(string), d.Get("domain_id").(string))
+	if certificate != nil {
+		d.Set("status", certificate["status"])
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// end resourceWPEngineDomainSSLCertificate
@@ -0,0 +1,99 @@
+package domain_ssl_certificate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestImportSetsType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/installs/abc/domains/d1/ssl_certificate" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"type":"custom","status":"active","issuer":"Example CA","expires_at":"2027-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	r := ResourceWPEngineDomainSSLCertificate()
+
+	d := r.TestResourceData()
+	d.SetId("abc/d1")
+
+	imported, err := r.Importer.StateContext(context.Background(), d, c)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("got %d resources, want 1", len(imported))
+	}
+
+	d = imported[0]
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for field, want := range map[string]string{
+		"install_id": "abc",
+		"domain_id":  "d1",
+		"type":       "custom",
+		"status":     "active",
+		"issuer":     "Example CA",
+	} {
+		if got := d.Get(field).(string); got != want {
+			t.Errorf("%s = %q, want %q", field, got, want)
+		}
+	}
+	if d.Id() != "abc/d1" {
+		t.Errorf("id = %q, want abc/d1", d.Id())
+	}
+}
+
+func TestImportRejectsMalformedID(t *testing.T) {
+	r := ResourceWPEngineDomainSSLCertificate()
+
+	d := r.TestResourceData()
+	d.SetId("abc")
+
+	if _, err := r.Importer.StateContext(context.Background(), d, nil); err == nil {
+		t.Fatal("expected an error for an ID without a domain")
+	}
+}
+
+func TestCreateKeepsIDWhenCertificateFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/abc/domains/d1/ssl_certificate":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"type":"letsencrypt","status":"pending"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/abc/domains/d1/ssl_certificate":
+			w.Write([]byte(`{"type":"letsencrypt","status":"failed"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineDomainSSLCertificate().Schema, map[string]interface{}{
+		"install_id": "abc",
+		"domain_id":  "d1",
+	})
+
+	if diags := resourceWPEngineDomainSSLCertificateCreate(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected an error when the certificate fails")
+	}
+	if d.Id() != "abc/d1" {
+		t.Errorf("id = %q, want the certificate kept in state", d.Id())
+	}
+	if got := d.Get("status"); got != "failed" {
+		t.Errorf("status = %v, want failed", got)
+	}
+}
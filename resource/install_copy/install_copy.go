@@ -0,0 +1,151 @@
+package install_copy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// #############################################################################
+// resourceWPEngineInstallCopy
+// #############################################################################
+
+func ResourceWPEngineInstallCopy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Copies one install onto another, e.g. staging to production, and waits for the copy to finish. The copy runs again whenever `triggers` changes. Destroying the resource does not undo the copy.",
+
+		CreateContext: resourceWPEngineInstallCopyCreate,
+		ReadContext:   resourceWPEngineInstallCopyRead,
+		DeleteContext: resourceWPEngineInstallCopyDelete,
+
+		CustomizeDiff: resourceWPEngineInstallCopyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_install_id": {
+				Description: "ID of the install to copy from.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"destination_install_id": {
+				Description: "ID of the install to overwrite.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:      "What to copy, one of `all`, `files` or `database`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "all",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"all", "files", "database"}, false)),
+			},
+			"backup_id": {
+				Description: "Copy from this backup of the source install instead of its current state.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary values that cause the copy to run again when they change.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Description: "Status of the copy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceWPEngineInstallCopyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// IDs that are only known after apply, e.g. from another resource, are
+	// checked again by the API
+	if !d.NewValueKnown("source_install_id") || !d.NewValueKnown("destination_install_id") {
+		return nil
+	}
+	if d.Get("source_install_id").(string) == d.Get("destination_install_id").(string) {
+		return fmt.Errorf("source_install_id and destination_install_id must differ")
+	}
+
+	return nil
+}
+
+func resourceWPEngineInstallCopyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	sourceID := d.Get("source_install_id").(string)
+	destinationID := d.Get("destination_install_id").(string)
+
+	copyData := map[string]interface{}{
+		"destination_install_id": destinationID,
+		"type":                   d.Get("type").(string),
+	}
+	if v, ok := d.GetOk("backup_id"); ok {
+		copyData["backup_id"] = v.(string)
+	}
+
+	installCopy, err := c.CopyInstall(ctx, sourceID, copyData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Record the copy straight away so a failed or timed out wait still
+	// leaves it in state
+	copyID, _ := installCopy["id"].(string)
+	if copyID == "" {
+		return diag.Errorf("copy of install %s to %s was started but the API returned no copy ID", sourceID, destinationID)
+	}
+	d.SetId(copyID)
+
+	installCopy, err = c.WaitForInstallCopy(ctx, sourceID, copyID)
+	if installCopy != nil {
+		d.Set("status", installCopy["status"])
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWPEngineInstallCopyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	// Copy records may expire once the copy is done. Keep the resource in state
+	// regardless, otherwise Terraform would copy the install again.
+	installCopy, err := c.GetInstallCopy(ctx, d.Get("source_install_id").(string), d.Id())
+	if client.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("status", installCopy["status"])
+
+	return nil
+}
+
+func resourceWPEngineInstallCopyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// end resourceWPEngineInstallCopy
@@ -0,0 +1,146 @@
+package install_copy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// unknown is the placeholder the SDK uses for values only known after apply
+const unknown = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestCustomizeDiffRejectsCopyOntoItself(t *testing.T) {
+	cases := []struct {
+		source, destination string
+		wantErr             bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "xyz", false},
+		{unknown, "abc", false},
+		{"abc", unknown, false},
+	}
+
+	for _, tc := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"source_install_id":      tc.source,
+			"destination_install_id": tc.destination,
+		})
+		_, err := ResourceWPEngineInstallCopy().Diff(context.Background(), nil, config, nil)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s -> %s: err = %v, want error %v", tc.source, tc.destination, err, tc.wantErr)
+		}
+	}
+}
+
+func TestCreateSendsBackupID(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/staging/copy":
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"c1","status":"requested"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/staging/copy/c1":
+			w.Write([]byte(`{"id":"c1","status":"completed"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineInstallCopy().Schema, map[string]interface{}{
+		"source_install_id":      "staging",
+		"destination_install_id": "production",
+		"type":                   "database",
+		"backup_id":              "b1",
+	})
+
+	if diags := resourceWPEngineInstallCopyCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+
+	want := map[string]interface{}{
+		"destination_install_id": "production",
+		"type":                   "database",
+		"backup_id":              "b1",
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("sent %v, want %v", body, want)
+	}
+	if d.Id() != "c1" {
+		t.Errorf("id = %q, want c1", d.Id())
+	}
+	if got := d.Get("status"); got != "completed" {
+		t.Errorf("status = %v, want completed", got)
+	}
+}
+
+func TestCreateKeepsIDWhenCopyFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/installs/staging/copy":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"c1","status":"requested"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/installs/staging/copy/c1":
+			w.Write([]byte(`{"id":"c1","status":"failed"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineInstallCopy().Schema, map[string]interface{}{
+		"source_install_id":      "staging",
+		"destination_install_id": "production",
+	})
+
+	if diags := resourceWPEngineInstallCopyCreate(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected an error when the copy fails")
+	}
+	if d.Id() != "c1" {
+		t.Errorf("id = %q, want the failed copy kept in state", d.Id())
+	}
+	if got := d.Get("status"); got != "failed" {
+		t.Errorf("status = %v, want failed", got)
+	}
+}
+
+func TestCreateRejectsMissingCopyID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/installs/staging/copy" {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"status":"requested"}`))
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineInstallCopy().Schema, map[string]interface{}{
+		"source_install_id":      "staging",
+		"destination_install_id": "production",
+	})
+
+	if diags := resourceWPEngineInstallCopyCreate(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected an error when the API returns no copy ID")
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the resource left out of state", d.Id())
+	}
+}
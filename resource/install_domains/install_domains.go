@@ -0,0 +1,323 @@
+package install_domains
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// platformDomainSuffixes are the host names WP Engine gives every install.
+// They cannot be removed, so they are never managed by this resource.
+var platformDomainSuffixes = []string{".wpengine.com", ".wpenginepowered.com"}
+
+// #############################################################################
+// resourceWPEngineInstallDomains
+// #############################################################################
+
+func ResourceWPEngineInstallDomains() *schema.Resource {
+	return &schema.Resource{
+		Description: "Authoritatively manages the full set of custom domains on an install. Domains not listed are removed, except the install's own WP Engine host names. Destroying the resource leaves every domain in place.",
+
+		CreateContext: resourceWPEngineInstallDomainsCreate,
+		ReadContext:   resourceWPEngineInstallDomainsRead,
+		UpdateContext: resourceWPEngineInstallDomainsUpdate,
+		DeleteContext: resourceWPEngineInstallDomainsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWPEngineInstallDomainsImport,
+		},
+
+		CustomizeDiff: resourceWPEngineInstallDomainsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domains": {
+				Description: "Every custom domain the install should have, in lower case.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateLowerCase,
+				},
+			},
+			"primary_domain": {
+				Description:      "Domain from `domains` to make the primary domain of the install, in lower case.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateLowerCase,
+			},
+			"max_concurrency": {
+				Description:      "Maximum number of domains added or removed at the same time.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 20)),
+			},
+			"domain_ids": {
+				Description: "IDs of the managed domains, keyed by domain name.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceWPEngineInstallDomainsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("domains") || !d.NewValueKnown("primary_domain") {
+		return nil
+	}
+
+	primary := d.Get("primary_domain").(string)
+	if !d.Get("domains").(*schema.Set).Contains(primary) {
+		return fmt.Errorf("primary_domain %q must be one of domains", primary)
+	}
+
+	return nil
+}
+
+func resourceWPEngineInstallDomainsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("install_id").(string))
+
+	if diags := reconcileDomains(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceWPEngineInstallDomainsRead(ctx, d, m)
+}
+
+func resourceWPEngineInstallDomainsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	current, err := managedDomains(ctx, c, d.Id())
+	if client.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	names := make([]string, 0, len(current))
+	ids := map[string]string{}
+	primary := ""
+	for name, domain := range current {
+		names = append(names, name)
+		ids[name], _ = domain["id"].(string)
+		if isPrimary, _ := domain["primary"].(bool); isPrimary {
+			primary = name
+		}
+	}
+
+	d.Set("install_id", d.Id())
+	d.Set("domains", names)
+	d.Set("primary_domain", primary)
+	d.Set("domain_ids", ids)
+
+	return diags
+}
+
+func resourceWPEngineInstallDomainsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("domains", "primary_domain") {
+		if diags := reconcileDomains(ctx, d, m); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceWPEngineInstallDomainsRead(ctx, d, m)
+}
+
+func resourceWPEngineInstallDomainsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Removing every domain, including the primary one, would take the site
+	// offline, so domains are left as they are
+	d.SetId("")
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Install domains were not removed",
+		Detail:   "Destroying wpengine_install_domains only stops Terraform from managing the install's domains. Every domain stays attached to the install.",
+	}}
+}
+
+func resourceWPEngineInstallDomainsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("install_id", d.Id())
+	d.Set("max_concurrency", 5)
+	return []*schema.ResourceData{d}, nil
+}
+
+// reconcileDomains adds missing domains, switches the primary domain and then
+// removes domains that are no longer configured. Removal happens last so the
+// install always keeps its primary domain.
+func reconcileDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+	primary := d.Get("primary_domain").(string)
+	limit := d.Get("max_concurrency").(int)
+
+	desired := map[string]bool{}
+	for _, name := range d.Get("domains").(*schema.Set).List() {
+		desired[name.(string)] = true
+	}
+
+	current, err := managedDomains(ctx, c, installID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var toAdd, toRemove []string
+	for name := range desired {
+		if _, ok := current[name]; !ok {
+			toAdd = append(toAdd, name)
+		}
+	}
+	for name, domain := range current {
+		if !desired[name] {
+			id, _ := domain["id"].(string)
+			toRemove = append(toRemove, id)
+		}
+	}
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+
+	diags := forEachConcurrently(toAdd, limit, func(name string) error {
+		_, err := c.CreateInstallDomain(ctx, installID, map[string]interface{}{"name": name})
+		if err != nil {
+			return fmt.Errorf("adding domain %s: %w", name, err)
+		}
+		return nil
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	// Look the primary domain up again, it may have just been added
+	current, err = managedDomains(ctx, c, installID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	primaryDomain, ok := current[primary]
+	if !ok {
+		return diag.Errorf("primary domain %s is not attached to install %s", primary, installID)
+	}
+	if isPrimary, _ := primaryDomain["primary"].(bool); !isPrimary {
+		primaryID, _ := primaryDomain["id"].(string)
+		_, err := c.PatchInstallDomain(ctx, installID, primaryID, map[string]interface{}{"primary": true})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("setting primary domain %s: %w", primary, err))
+		}
+	}
+
+	return forEachConcurrently(toRemove, limit, func(domainID string) error {
+		return deleteDomain(ctx, c, installID, domainID)
+	})
+}
+
+// managedDomains lists the domains on an install keyed by lower case name,
+// leaving out WP Engine's own host names.
+func managedDomains(ctx context.Context, c *client.ApiClient, installID string) (map[string]map[string]interface{}, error) {
+	domains, err := c.ListDomains(ctx, installID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]map[string]interface{}{}
+	for _, domain := range domains {
+		name, _ := domain["name"].(string)
+		name = strings.ToLower(name)
+		if name == "" || isPlatformDomain(name) {
+			continue
+		}
+		result[name] = domain
+	}
+	return result, nil
+}
+
+// validateLowerCase rejects domain names with upper case letters. The API
+// treats names case-insensitively and reports them in lower case, so mixed case
+// in configuration would never match state.
+func validateLowerCase(v interface{}, path cty.Path) diag.Diagnostics {
+	name := v.(string)
+	if name != strings.ToLower(name) {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Domain name must be lower case",
+			Detail:        fmt.Sprintf("Use %q instead of %q.", strings.ToLower(name), name),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+func isPlatformDomain(name string) bool {
+	for _, suffix := range platformDomainSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteDomain(ctx context.Context, c *client.ApiClient, installID, domainID string) error {
+	err := c.DeleteInstallDomain(ctx, installID, domainID)
+	if err != nil && !client.IsNotFound(err) {
+		return fmt.Errorf("removing domain %s: %w", domainID, err)
+	}
+	return nil
+}
+
+// forEachConcurrently calls fn for every item with at most limit calls in
+// flight, and reports every failure rather than only the first.
+func forEachConcurrently(items []string, limit int, fn func(string) error) diag.Diagnostics {
+	var (
+		diags diag.Diagnostics
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, limit)
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(item string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(item); err != nil {
+				mu.Lock()
+				diags = append(diags, diag.FromErr(err)...)
+				mu.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+
+	return diags
+}
+
+// end resourceWPEngineInstallDomains
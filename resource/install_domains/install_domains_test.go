@@ -0,0 +1,67 @@
+package install_domains
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachConcurrentlyRespectsLimit(t *testing.T) {
+	var inFlight, peak int32
+
+	items := make([]string, 20)
+	for i := range items {
+		items[i] = fmt.Sprintf("domain-%d.example.com", i)
+	}
+
+	diags := forEachConcurrently(items, 3, func(item string) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+
+		if item == "domain-7.example.com" || item == "domain-9.example.com" {
+			return fmt.Errorf("failed %s", item)
+		}
+		return nil
+	})
+
+	if peak > 3 {
+		t.Errorf("peak concurrency = %d, want at most 3", peak)
+	}
+	if len(diags) != 2 {
+		t.Errorf("got %d diagnostics, want one per failure: %v", len(diags), diags)
+	}
+}
+
+func TestIsPlatformDomain(t *testing.T) {
+	for name, want := range map[string]bool{
+		"mysite.wpengine.com":        true,
+		"mysite.wpenginepowered.com": true,
+		"example.com":                false,
+		"wpengine.com.example.com":   false,
+	} {
+		if got := isPlatformDomain(name); got != want {
+			t.Errorf("isPlatformDomain(%q) = %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestValidateLowerCase(t *testing.T) {
+	for name, wantErr := range map[string]bool{
+		"example.com":     false,
+		"www.example.com": false,
+		"Example.com":     true,
+		"WWW.EXAMPLE.COM": true,
+	} {
+		if diags := validateLowerCase(name, nil); diags.HasError() != wantErr {
+			t.Errorf("validateLowerCase(%q) = %v, want error %t", name, diags, wantErr)
+		}
+	}
+}
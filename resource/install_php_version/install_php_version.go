@@ -0,0 +1,131 @@
+package install_php_version
+
+import (
+	"context"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// supportedPHPVersions are the PHP versions WP Engine installs can run.
+var supportedPHPVersions = []string{"7.4", "8.0", "8.1", "8.2", "8.3"}
+
+// #############################################################################
+// resourceWPEngineInstallPHPVersion
+// #############################################################################
+
+func ResourceWPEngineInstallPHPVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sets the PHP version of an install and waits for the change to be applied. Destroying the resource leaves the install on its current version.",
+
+		CreateContext: resourceWPEngineInstallPHPVersionCreate,
+		ReadContext:   resourceWPEngineInstallPHPVersionRead,
+		UpdateContext: resourceWPEngineInstallPHPVersionUpdate,
+		DeleteContext: resourceWPEngineInstallPHPVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWPEngineInstallPHPVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"install_id": {
+				Description: "ID of the install.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"php_version": {
+				Description:      "PHP version to run, e.g. `8.2`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(supportedPHPVersions, false)),
+			},
+		},
+	}
+}
+
+func resourceWPEngineInstallPHPVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("install_id").(string))
+
+	if diags := setPHPVersion(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceWPEngineInstallPHPVersionRead(ctx, d, m)
+}
+
+func resourceWPEngineInstallPHPVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	install, err := c.GetInstall(ctx, d.Id())
+	if client.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("install_id", d.Id())
+	d.Set("php_version", install["php_version"])
+
+	return diags
+}
+
+func resourceWPEngineInstallPHPVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("php_version") {
+		if diags := setPHPVersion(ctx, d, m); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceWPEngineInstallPHPVersionRead(ctx, d, m)
+}
+
+func resourceWPEngineInstallPHPVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// There is no version to go back to, so the install keeps its current one
+	d.SetId("")
+	return nil
+}
+
+func resourceWPEngineInstallPHPVersionImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("install_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// setPHPVersion changes only the PHP version of the install and waits until
+// the install reports running it.
+func setPHPVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	installID := d.Get("install_id").(string)
+	phpVersion := d.Get("php_version").(string)
+
+	_, err := c.PatchInstall(ctx, installID, map[string]interface{}{
+		"php_version": phpVersion,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = c.WaitForInstallPHPVersion(ctx, installID, phpVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// end resourceWPEngineInstallPHPVersion
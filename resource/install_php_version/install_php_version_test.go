@@ -0,0 +1,120 @@
+package install_php_version
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newInstallServer fakes an install that starts on PHP 8.1 and only reports a
+// new version after it has been polled a couple of times.
+func newInstallServer(t *testing.T, patched *[]map[string]interface{}) *httptest.Server {
+	current, pending, polls := "8.1", "", 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/installs/abc" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decode body: %v", err)
+			}
+			*patched = append(*patched, body)
+			pending, _ = body["php_version"].(string)
+			polls = 0
+		case http.MethodGet:
+			if pending != "" {
+				if polls++; polls > 2 {
+					current, pending = pending, ""
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":          "abc",
+			"name":        "mysite",
+			"php_version": current,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCreateSendsOnlyPHPVersionAndWaits(t *testing.T) {
+	var patched []map[string]interface{}
+	server := newInstallServer(t, &patched)
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineInstallPHPVersion().Schema, map[string]interface{}{
+		"install_id":  "abc",
+		"php_version": "8.2",
+	})
+
+	if diags := resourceWPEngineInstallPHPVersionCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+
+	want := []map[string]interface{}{{"php_version": "8.2"}}
+	if !reflect.DeepEqual(patched, want) {
+		t.Errorf("patched %v, want %v", patched, want)
+	}
+	if d.Id() != "abc" {
+		t.Errorf("id = %q, want abc", d.Id())
+	}
+	if got := d.Get("php_version"); got != "8.2" {
+		t.Errorf("php_version = %v, want 8.2 once the install reports it", got)
+	}
+}
+
+func TestCreateTimesOutWaitingForVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"abc","php_version":"8.1"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL), client.WithPollInterval(time.Millisecond))
+	d := schema.TestResourceDataRaw(t, ResourceWPEngineInstallPHPVersion().Schema, map[string]interface{}{
+		"install_id":  "abc",
+		"php_version": "8.2",
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if diags := resourceWPEngineInstallPHPVersionCreate(ctx, d, c); !diags.HasError() {
+		t.Fatal("expected an error when the install never reports the new version")
+	}
+	if d.Id() != "abc" {
+		t.Errorf("id = %q, want the install kept in state", d.Id())
+	}
+}
+
+func TestReadRemovesMissingInstall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"not found"}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient("user", "password", client.WithBaseURL(server.URL))
+	d := ResourceWPEngineInstallPHPVersion().TestResourceData()
+	d.SetId("abc")
+
+	if diags := resourceWPEngineInstallPHPVersionRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the resource removed from state", d.Id())
+	}
+}
@@ -0,0 +1,182 @@
+package user_install_access
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// userLocks serialises changes to the same user's install list, which would
+// otherwise race when several bindings for one user are applied in parallel.
+// It only covers a single provider process; concurrent runs against other
+// states can still overwrite each other's changes.
+var userLocks sync.Map
+
+func lockUser(userID string) func() {
+	mu, _ := userLocks.LoadOrStore(userID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// #############################################################################
+// resourceWPEngineUserInstallAccess
+// #############################################################################
+
+func ResourceWPEngineUserInstallAccess() *schema.Resource {
+	return &schema.Resource{
+		Description: "Grants a partial account user access to a single install. Access to other installs is left untouched. The API only accepts the user's full install list, so grants for the same user must not be applied from separate Terraform runs at the same time, or one of them may be lost.",
+
+		CreateContext: resourceWPEngineUserInstallAccessCreate,
+		ReadContext:   resourceWPEngineUserInstallAccessRead,
+		DeleteContext: resourceWPEngineUserInstallAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWPEngineUserInstallAccessImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "ID of the account the user and install belong to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "ID of the account user.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"install_id": {
+				Description: "ID of the install to grant access to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceWPEngineUserInstallAccessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.ApiClient)
+
+	accountID := d.Get("account_id").(string)
+	userID := d.Get("user_id").(string)
+	installID := d.Get("install_id").(string)
+
+	defer lockUser(userID)()
+
+	user, err := c.GetAccountUserForAccount(ctx, accountID, userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	roles, _ := user["roles"].(string)
+	if !client.HasRole(roles, "partial") {
+		return diag.Errorf("user %s has roles %q; install access can only be granted to partial users", userID, roles)
+	}
+
+	if !client.AccountUserHasInstall(user, installID) {
+		_, err = c.PatchAccountUserForAccount(ctx, accountID, userID, map[string]interface{}{
+			"install_ids": append(client.AccountUserInstallIDs(user), installID),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(strings.Join([]string{accountID, userID, installID}, "/"))
+
+	return resourceWPEngineUserInstallAccessRead(ctx, d, m)
+}
+
+func resourceWPEngineUserInstallAccessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	user, err := c.GetAccountUserForAccount(ctx, d.Get("account_id").(string), d.Get("user_id").(string))
+	if client.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Access revoked outside of Terraform is granted again on the next apply
+	if !client.AccountUserHasInstall(user, d.Get("install_id").(string)) {
+		d.SetId("")
+	}
+
+	return diags
+}
+
+func resourceWPEngineUserInstallAccessDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := m.(*client.ApiClient)
+
+	accountID := d.Get("account_id").(string)
+	userID := d.Get("user_id").(string)
+	installID := d.Get("install_id").(string)
+
+	defer lockUser(userID)()
+
+	user, err := c.GetAccountUserForAccount(ctx, accountID, userID)
+	if client.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if client.AccountUserHasInstall(user, installID) {
+		// Sent as an empty list rather than null when the last grant goes
+		remaining := []string{}
+		for _, id := range client.AccountUserInstallIDs(user) {
+			if id != installID {
+				remaining = append(remaining, id)
+			}
+		}
+
+		_, err = c.PatchAccountUserForAccount(ctx, accountID, userID, map[string]interface{}{
+			"install_ids": remaining,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceWPEngineUserInstallAccessImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("expected an ID of the form <account_id>/<user_id>/<install_id>, got %q", d.Id())
+	}
+
+	d.Set("account_id", parts[0])
+	d.Set("user_id", parts[1])
+	d.Set("install_id", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// end resourceWPEngineUserInstallAccess
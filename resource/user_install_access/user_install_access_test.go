@@ -0,0 +1,143 @@
+package user_install_access
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/drzln/terraform-provider-wpengine/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newTestServer serves a single account user with the given roles and
+// installs, applying PATCH requests to it and recording their bodies.
+func newTestServer(t *testing.T, roles string, installIDs ...string) (*client.ApiClient, *[]string) {
+	t.Helper()
+
+	var patches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/acct/account_users/u1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			patches = append(patches, string(body))
+
+			var fields struct {
+				InstallIDs []string `json:"install_ids"`
+			}
+			json.Unmarshal(body, &fields)
+			installIDs = fields.InstallIDs
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+
+		installs := []map[string]string{}
+		for _, id := range installIDs {
+			installs = append(installs, map[string]string{"id": id})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"user_id":  "u1",
+			"roles":    roles,
+			"installs": installs,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return client.NewClient("user", "password", client.WithBaseURL(server.URL)), &patches
+}
+
+func testResourceData(t *testing.T, installID string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceWPEngineUserInstallAccess().Schema, map[string]interface{}{
+		"account_id": "acct",
+		"user_id":    "u1",
+		"install_id": installID,
+	})
+}
+
+func TestCreateAddsInstallOnly(t *testing.T) {
+	c, patches := newTestServer(t, "partial,billing", "a")
+	d := testResourceData(t, "b")
+
+	if diags := resourceWPEngineUserInstallAccessCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(*patches) != 1 || (*patches)[0] != `{"install_ids":["a","b"]}` {
+		t.Errorf("patches = %v", *patches)
+	}
+	if d.Id() != "acct/u1/b" {
+		t.Errorf("id = %q", d.Id())
+	}
+}
+
+func TestCreateExistingGrantSendsNothing(t *testing.T) {
+	c, patches := newTestServer(t, "partial", "a", "b")
+	d := testResourceData(t, "b")
+
+	if diags := resourceWPEngineUserInstallAccessCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(*patches) != 0 {
+		t.Errorf("patches = %v, want none", *patches)
+	}
+}
+
+func TestCreateRejectsFullUser(t *testing.T) {
+	c, patches := newTestServer(t, "full")
+	d := testResourceData(t, "a")
+
+	if diags := resourceWPEngineUserInstallAccessCreate(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected an error for a user without the partial role")
+	}
+	if len(*patches) != 0 {
+		t.Errorf("patches = %v, want none", *patches)
+	}
+}
+
+func TestDeleteKeepsOtherInstalls(t *testing.T) {
+	c, patches := newTestServer(t, "partial", "a", "b", "c")
+	d := testResourceData(t, "b")
+	d.SetId("acct/u1/b")
+
+	if diags := resourceWPEngineUserInstallAccessDelete(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(*patches) != 1 || (*patches)[0] != `{"install_ids":["a","c"]}` {
+		t.Errorf("patches = %v", *patches)
+	}
+}
+
+func TestDeleteLastInstallSendsEmptyList(t *testing.T) {
+	c, patches := newTestServer(t, "partial", "a")
+	d := testResourceData(t, "a")
+	d.SetId("acct/u1/a")
+
+	if diags := resourceWPEngineUserInstallAccessDelete(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(*patches) != 1 || (*patches)[0] != `{"install_ids":[]}` {
+		t.Errorf("patches = %v", *patches)
+	}
+}
+
+func TestReadDropsRevokedGrant(t *testing.T) {
+	c, _ := newTestServer(t, "partial", "a")
+	d := testResourceData(t, "b")
+	d.SetId("acct/u1/b")
+
+	if diags := resourceWPEngineUserInstallAccessRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want the revoked grant removed from state", d.Id())
+	}
+}